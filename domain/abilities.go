@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+const (
+	MethodManual        = "manual"
+	MethodPointBuy      = "pointbuy"
+	MethodStandardArray = "array"
+	MethodRoll          = "roll"
+)
+
+const PointBuyBudget = 27
+
+var pointBuyCosts = map[int]int{
+	8: 0, 9: 1, 10: 2, 11: 3, 12: 4, 13: 5, 14: 7, 15: 9,
+}
+
+var StandardArray = []int{15, 14, 13, 12, 10, 8}
+
+var AbilityNames = []string{"STR", "DEX", "CON", "INT", "WIS", "CHA"}
+
+type AbilityRoll struct {
+	Ability string
+	Dice    []int
+	Dropped int
+	Total   int
+}
+
+func (a AbilityScores) Values() []int {
+	return []int{a.Str, a.Dex, a.Con, a.Int, a.Wis, a.Cha}
+}
+
+func (a AbilityScores) Get(ability string) int {
+	switch strings.ToUpper(ability) {
+	case "STR":
+		return a.Str
+	case "DEX":
+		return a.Dex
+	case "CON":
+		return a.Con
+	case "INT":
+		return a.Int
+	case "WIS":
+		return a.Wis
+	case "CHA":
+		return a.Cha
+	default:
+		return 0
+	}
+}
+
+func IsAbility(ability string) bool {
+	for _, a := range AbilityNames {
+		if strings.EqualFold(a, ability) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *AbilityScores) add(ability string, amount int) {
+	switch strings.ToUpper(ability) {
+	case "STR":
+		a.Str += amount
+	case "DEX":
+		a.Dex += amount
+	case "CON":
+		a.Con += amount
+	case "INT":
+		a.Int += amount
+	case "WIS":
+		a.Wis += amount
+	case "CHA":
+		a.Cha += amount
+	}
+}
+
+func AbilityScoresFromValues(values []int) AbilityScores {
+	return AbilityScores{
+		Str: values[0], Dex: values[1], Con: values[2],
+		Int: values[3], Wis: values[4], Cha: values[5],
+	}
+}
+
+func PointBuyCost(scores AbilityScores) (int, error) {
+	total := 0
+	for i, v := range scores.Values() {
+		cost, ok := pointBuyCosts[v]
+		if !ok {
+			return 0, fmt.Errorf("point buy scores must be between 8 and 15, got %s %d", AbilityNames[i], v)
+		}
+		total += cost
+	}
+	return total, nil
+}
+
+func ValidatePointBuy(scores AbilityScores) error {
+	cost, err := PointBuyCost(scores)
+	if err != nil {
+		return err
+	}
+	if cost > PointBuyBudget {
+		return fmt.Errorf("point buy costs %d points, budget is %d", cost, PointBuyBudget)
+	}
+	return nil
+}
+
+func ValidateStandardArray(scores AbilityScores) error {
+	values := scores.Values()
+	sort.Sort(sort.Reverse(sort.IntSlice(values)))
+	for i := range StandardArray {
+		if values[i] != StandardArray[i] {
+			return fmt.Errorf("standard array requires each of %v assigned exactly once", StandardArray)
+		}
+	}
+	return nil
+}
+
+func RollAbilityScores(seed int64) (AbilityScores, []AbilityRoll) {
+	rng := rand.New(rand.NewSource(seed))
+	rolls := make([]AbilityRoll, 0, len(AbilityNames))
+	values := make([]int, 0, len(AbilityNames))
+
+	for _, ability := range AbilityNames {
+		dice := make([]int, 4)
+		lowest := 0
+		sum := 0
+		for i := range dice {
+			dice[i] = rng.Intn(6) + 1
+			sum += dice[i]
+			if dice[i] < dice[lowest] {
+				lowest = i
+			}
+		}
+		roll := AbilityRoll{
+			Ability: ability,
+			Dice:    dice,
+			Dropped: dice[lowest],
+			Total:   sum - dice[lowest],
+		}
+		rolls = append(rolls, roll)
+		values = append(values, roll.Total)
+	}
+
+	return AbilityScoresFromValues(values), rolls
+}
+
+func GenerateAbilityScores(method string, scores AbilityScores, seed int64) (AbilityScores, error) {
+	switch strings.ToLower(method) {
+	case "", MethodManual:
+		return scores, nil
+	case MethodPointBuy:
+		if err := ValidatePointBuy(scores); err != nil {
+			return AbilityScores{}, err
+		}
+		return scores, nil
+	case MethodStandardArray:
+		if err := ValidateStandardArray(scores); err != nil {
+			return AbilityScores{}, err
+		}
+		return scores, nil
+	case MethodRoll:
+		rolled, _ := RollAbilityScores(seed)
+		return rolled, nil
+	default:
+		return AbilityScores{}, fmt.Errorf("unknown ability score method: %s", method)
+	}
+}
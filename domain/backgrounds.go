@@ -0,0 +1,621 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+const (
+	CustomBackgroundSkills      = 2
+	CustomBackgroundProficiency = 2
+	personalityTraitCount       = 2
+)
+
+type BackgroundData struct {
+	Name              string
+	Skills            []string
+	Tools             []string
+	LanguageChoices   int
+	Equipment         []string
+	Gold              int
+	Feature           Trait
+	PersonalityTraits []string
+	Ideals            []string
+	Bonds             []string
+	Flaws             []string
+}
+
+var backgroundRegistry = map[string]BackgroundData{
+	"acolyte": {
+		Name: "Acolyte", Skills: []string{SkillInsight, SkillReligion}, LanguageChoices: 2,
+		Equipment: []string{"holy symbol", "prayer book", "5 sticks of incense", "vestments", "common clothes", "belt pouch"},
+		Gold:      15,
+		Feature:   Trait{"Shelter of the Faithful", "Temples of your faith offer you and your companions healing, care and modest lodging."},
+		PersonalityTraits: []string{
+			"I idolize a particular hero of my faith and constantly refer to that person's deeds and example.",
+			"I can find common ground between the fiercest enemies, empathizing with them and always working toward peace.",
+			"I see omens in every event and action. The gods try to speak to us, we just need to listen.",
+			"Nothing can shake my optimistic attitude.",
+			"I quote (or misquote) sacred texts and proverbs in almost every situation.",
+			"I am tolerant (or intolerant) of other faiths and respect (or condemn) the worship of other gods.",
+			"I've enjoyed fine food, drink and high society among my temple's elite. Rough living grates on me.",
+			"I've spent so long in the temple that I have little practical experience dealing with people in the outside world.",
+		},
+		Ideals: []string{
+			"Tradition: the ancient traditions of worship and sacrifice must be preserved and upheld.",
+			"Charity: I always try to help those in need, no matter what the personal cost.",
+			"Change: we must help bring about the changes the gods are constantly working in the world.",
+			"Power: I hope to one day rise to the top of my faith's religious hierarchy.",
+			"Faith: I trust that my deity will guide my actions. I have faith that if I work hard, things will go well.",
+			"Aspiration: I seek to prove myself worthy of my god's favor by matching my actions against their teachings.",
+		},
+		Bonds: []string{
+			"I would die to recover an ancient relic of my faith that was lost long ago.",
+			"I will someday get revenge on the corrupt temple hierarchy who branded me a heretic.",
+			"I owe my life to the priest who took me in when my parents died.",
+			"Everything I do is for the common people.",
+			"I will do anything to protect the temple where I served.",
+			"I seek to preserve a sacred text that my enemies consider heretical and seek to destroy.",
+		},
+		Flaws: []string{
+			"I judge others harshly, and myself even more severely.",
+			"I put too much trust in those who wield power within my temple's hierarchy.",
+			"My piety sometimes leads me to blindly trust those that profess faith in my god.",
+			"I am inflexible in my thinking.",
+			"I am suspicious of strangers and expect the worst of them.",
+			"Once I pick a goal, I become obsessed with it to the detriment of everything else in my life.",
+		},
+	},
+	"charlatan": {
+		Name: "Charlatan", Skills: []string{SkillDeception, SkillSleightOfHand}, Tools: []string{"disguise kit", "forgery kit"},
+		Equipment: []string{"fine clothes", "disguise kit", "tools of the con", "belt pouch"},
+		Gold:      15,
+		Feature:   Trait{"False Identity", "You keep a second identity with documents, acquaintances and disguises."},
+		PersonalityTraits: []string{
+			"I fall in and out of love easily.",
+			"I have a joke for every occasion.",
+			"Flattery is my preferred trick for getting what I want.",
+			"I lie about almost everything, even when there is no reason to.",
+			"I keep a lucky coin that I flip before every big decision.",
+			"I can talk my way into a party and out of a prison, usually in that order.",
+			"I study people's hands, because hands never lie.",
+			"I always have a sad story ready for whoever will listen.",
+		},
+		Ideals: []string{
+			"Independence: I am a free spirit.",
+			"Fairness: I never target people who cannot afford to lose a few coins.",
+			"Creativity: I never run the same con twice.",
+			"Charity: I take from the greedy and give a share to the desperate.",
+			"Friendship: material goods come and go, but the people who trust me matter.",
+			"Aspiration: I will pull off one con so grand it becomes a legend.",
+		},
+		Bonds: []string{
+			"I fleeced the wrong person and must stay ahead of them.",
+			"I owe everything to my mentor, a terrible con artist.",
+			"A child I once helped still writes to me.",
+			"My sibling does not know what I do for a living, and I mean to keep it that way.",
+			"A powerful patron bankrolled my first scheme and still expects a cut.",
+			"I swore to ruin the swindler who cheated my family.",
+		},
+		Flaws: []string{
+			"I cannot resist a pretty face.",
+			"I am always in debt.",
+			"I turn tail and run when things look bad.",
+			"I cannot walk away from an easy mark.",
+			"I believe my own lies a little too often.",
+			"I sell out my partners when the stakes get high enough.",
+		},
+	},
+	"criminal": {
+		Name: "Criminal", Skills: []string{SkillDeception, SkillStealth}, Tools: []string{"one gaming set (choose)", "thieves' tools"},
+		Equipment: []string{"crowbar", "dark common clothes with a hood", "belt pouch"},
+		Gold:      15,
+		Feature:   Trait{"Criminal Contact", "You have a reliable contact who acts as your liaison to a network of criminals."},
+		PersonalityTraits: []string{
+			"I always have a plan for when things go wrong.",
+			"I am always calm, no matter the situation.",
+			"The first thing I do in a new place is note the exits.",
+			"I would rather make a new friend than a new enemy.",
+			"I count my coins twice and everyone else's once.",
+			"I never sit with my back to a door.",
+			"I speak softly and rarely repeat myself.",
+			"I keep a blade within reach even when I sleep.",
+		},
+		Ideals: []string{
+			"Honor: I do not steal from others in the trade.",
+			"Freedom: chains are meant to be broken.",
+			"Greed: I will do whatever it takes to become wealthy.",
+			"Charity: I steal from the wealthy so that I can help people in need.",
+			"Redemption: there is a spark of good in everyone, including me.",
+			"People: I am loyal to my friends, not to any ideal.",
+		},
+		Bonds: []string{
+			"I am trying to pay off an old debt.",
+			"My ill-gotten gains support my family.",
+			"Someone I loved died because of a mistake I made.",
+			"I will become the greatest thief that ever lived.",
+			"Something important was taken from me, and I aim to steal it back.",
+			"A fence I trust holds everything I own, and I owe them my life.",
+		},
+		Flaws: []string{
+			"When I see something valuable, I think about how to steal it.",
+			"I turn tail and run when things go bad.",
+			"An innocent person is in prison for a crime I committed.",
+			"I would rather kill someone in their sleep than fight fair.",
+			"I cannot keep a secret if someone offers enough coin for it.",
+			"I panic whenever a guard looks at me twice.",
+		},
+	},
+	"entertainer": {
+		Name: "Entertainer", Skills: []string{SkillAcrobatics, SkillPerformance}, Tools: []string{"disguise kit", "one musical instrument (choose)"},
+		Equipment: []string{"musical instrument", "favor of an admirer", "costume", "belt pouch"},
+		Gold:      15,
+		Feature:   Trait{"By Popular Demand", "You can always find a place to perform and receive free lodging and food in return."},
+		PersonalityTraits: []string{
+			"I know a story relevant to almost every situation.",
+			"Whenever I come to a new place, I collect local rumors and songs.",
+			"I change my mood as quickly as I change key in a song.",
+			"I love a good insult, even one directed at me.",
+			"I hum constantly, even when I should be quiet.",
+			"I cannot bear an audience that is not paying attention.",
+			"I treat every meal like a banquet held in my honor.",
+			"I throw a tantrum when my art is criticized.",
+		},
+		Ideals: []string{
+			"Beauty: my performances make the world better.",
+			"Tradition: the stories of old must be remembered.",
+			"Honesty: art should reflect the soul.",
+			"Creativity: the world needs new ideas and bold action.",
+			"Freedom: no one should be bound by the expectations of others.",
+			"People: I like seeing the smiles on people's faces when I perform.",
+		},
+		Bonds: []string{
+			"My instrument is my most treasured possession.",
+			"I want to be famous, whatever it takes.",
+			"I would do anything for the members of my old troupe.",
+			"Someone stole my precious instrument, and someday I will get it back.",
+			"I perform to honor the teacher who gave me my first stage.",
+			"A rival performer humiliated me, and I will outshine them.",
+		},
+		Flaws: []string{
+			"I will do anything to win fame and renown.",
+			"I am a sucker for a pretty face.",
+			"I have trouble keeping my true feelings hidden.",
+			"I am vain about my looks and hate to be seen at my worst.",
+			"I cannot resist a wager, especially one I am sure to lose.",
+			"I mock everyone, even people who deserve kindness.",
+		},
+	},
+	"folk hero": {
+		Name: "Folk Hero", Skills: []string{SkillAnimalHandling, SkillSurvival}, Tools: []string{"one artisan's tools (choose)", "vehicles (land)"},
+		Equipment: []string{"artisan's tools", "shovel", "iron pot", "common clothes", "belt pouch"},
+		Gold:      10,
+		Feature:   Trait{"Rustic Hospitality", "Common folk will shelter you and hide you from the law, though not at risk to their lives."},
+		PersonalityTraits: []string{
+			"I judge people by their actions, not their words.",
+			"If someone is in trouble, I am always ready to lend help.",
+			"When I set my mind to something, I follow through.",
+			"I have a strong sense of fair play.",
+			"I fix whatever I can get my hands on.",
+			"I speak plainly and have no patience for fancy talk.",
+			"I am confident in my own abilities and do what I can to instill confidence in others.",
+			"I misuse long words in an attempt to sound smarter.",
+		},
+		Ideals: []string{
+			"Respect: people deserve to be treated with dignity.",
+			"Fairness: no one should get preferential treatment.",
+			"Destiny: nothing can steer me away from my calling.",
+			"Freedom: tyrants must not be allowed to oppress the people.",
+			"Sincerity: there is no good in pretending to be something I am not.",
+			"Might: if I become strong, I can take what I want.",
+		},
+		Bonds: []string{
+			"I protect those who cannot protect themselves.",
+			"I wish my childhood sweetheart had come with me.",
+			"A tyrant wronged my village and will pay for it.",
+			"I have a family, but I have no idea where they are, and I hope to see them again.",
+			"I worked the land, I love the land, and I will protect the land.",
+			"A proud noble once beat me, and I will take my revenge on any bully I meet.",
+		},
+		Flaws: []string{
+			"I am convinced of the significance of my destiny.",
+			"I have a weakness for the vices of the city.",
+			"Secretly, I believe things would be better if I were in charge.",
+			"I am too stubborn to admit when I am wrong.",
+			"I have trouble trusting in my allies.",
+			"I get angry whenever someone mentions the home I left behind.",
+		},
+	},
+	"guild artisan": {
+		Name: "Guild Artisan", Skills: []string{SkillInsight, SkillPersuasion}, Tools: []string{"one artisan's tools (choose)"}, LanguageChoices: 1,
+		Equipment: []string{"artisan's tools", "letter of introduction from your guild", "traveler's clothes", "belt pouch"},
+		Gold:      15,
+		Feature:   Trait{"Guild Membership", "Your guild offers lodging, legal support and access to powerful patrons."},
+		PersonalityTraits: []string{
+			"I believe that anything worth doing is worth doing right.",
+			"I am a snob who looks down on those who cannot appreciate fine art.",
+			"I always want to know how things work.",
+			"I am full of witty aphorisms for every occasion.",
+			"I am rude to people who lack my commitment to hard work.",
+			"I like to talk at length about my profession.",
+			"I do not part with my money easily and will haggle tirelessly.",
+			"I am well known for my work and want everyone to appreciate it.",
+		},
+		Ideals: []string{
+			"Community: civilization depends on us working together.",
+			"Generosity: my talents were given to me to benefit the world.",
+			"Aspiration: I work hard to be the best at my craft.",
+			"Tradition: the old ways of my craft must be kept alive.",
+			"Freedom: everyone should be free to pursue their own livelihood.",
+			"Greed: I am only in it for the money.",
+		},
+		Bonds: []string{
+			"The workshop where I learned my trade is the most important place in the world.",
+			"I created a great work for someone who did not deserve it.",
+			"I owe my guild a great debt.",
+			"I pursue wealth to secure someone's love.",
+			"One day I will return to my guild and prove that I am the greatest artisan of them all.",
+			"I seek revenge on the rival who destroyed my workshop.",
+		},
+		Flaws: []string{
+			"I will do anything to get my hands on something rare.",
+			"I am quick to assume someone is trying to cheat me.",
+			"No one must ever learn that I once stole from my guild.",
+			"I am never satisfied with what I have and always want more.",
+			"I boast about my skill far beyond what I can deliver.",
+			"I would sell out a friend to win a valuable commission.",
+		},
+	},
+	"hermit": {
+		Name: "Hermit", Skills: []string{SkillMedicine, SkillReligion}, Tools: []string{"herbalism kit"}, LanguageChoices: 1,
+		Equipment: []string{"scroll case of notes", "winter blanket", "common clothes", "herbalism kit"},
+		Gold:      5,
+		Feature:   Trait{"Discovery", "Your seclusion granted you a unique and powerful discovery."},
+		PersonalityTraits: []string{
+			"I have been isolated so long that I rarely speak.",
+			"I am utterly serene, even in the face of disaster.",
+			"I connect everything that happens to a grand cosmic plan.",
+			"I often get lost in my own thoughts.",
+			"I am working on a grand philosophical theory and love sharing my ideas.",
+			"I feel tremendous empathy for all who suffer.",
+			"I am oblivious to etiquette and social expectations.",
+			"I forget names almost as soon as I hear them.",
+		},
+		Ideals: []string{
+			"Greater Good: my gifts are meant to be shared.",
+			"Self-Knowledge: if you know yourself, there is nothing left to know.",
+			"Free Thinking: inquiry is the path to progress.",
+			"Solitude: quiet reflection reveals truths that crowds drown out.",
+			"Logic: every problem can be solved with patient reasoning.",
+			"Power: solitude and contemplation are paths toward mystical or magical power.",
+		},
+		Bonds: []string{
+			"Nothing is more important than the other members of my order.",
+			"I entered seclusion to hide from those who might still hunt me.",
+			"I am still seeking the enlightenment I pursued in seclusion.",
+			"My isolation gave me great insight into a great evil that only I can destroy.",
+			"I left behind someone I love, and I hope to return to them.",
+			"The place I lived in seclusion must be protected from outsiders.",
+		},
+		Flaws: []string{
+			"I harbor dark thoughts that my isolation failed to quell.",
+			"I am dogmatic in my thoughts and philosophy.",
+			"I let my need to win arguments overshadow friendships.",
+			"Now that I have returned to the world, I enjoy its delights a little too much.",
+			"I distrust any idea that I did not arrive at on my own.",
+			"I would risk too much to uncover a lost bit of knowledge.",
+		},
+	},
+	"noble": {
+		Name: "Noble", Skills: []string{SkillHistory, SkillPersuasion}, Tools: []string{"one gaming set (choose)"}, LanguageChoices: 1,
+		Equipment: []string{"fine clothes", "signet ring", "scroll of pedigree", "purse"},
+		Gold:      25,
+		Feature:   Trait{"Position of Privilege", "You are welcome in high society and common folk make every effort to accommodate you."},
+		PersonalityTraits: []string{
+			"My eloquent flattery makes everyone I talk to feel important.",
+			"Despite my noble birth, I do not place myself above other folk.",
+			"I take great pains to always look my best.",
+			"If you do me an injury, I will crush you.",
+			"The common folk love me for my kindness and generosity.",
+			"No one could doubt by looking at my regal bearing that I am a cut above the unwashed masses.",
+			"I do not like to get my hands dirty.",
+			"I am used to being obeyed and bristle when I am not.",
+		},
+		Ideals: []string{
+			"Responsibility: it is my duty to protect those beneath me.",
+			"Power: if I can attain more power, no one will tell me what to do.",
+			"Family: blood runs thicker than water.",
+			"Tradition: I am bound to uphold the traditions of my family.",
+			"Noble Obligation: it is my duty to protect and care for the people beneath me.",
+			"Independence: I must prove that I can handle myself without the coddling of my family.",
+		},
+		Bonds: []string{
+			"I will face any challenge to win my family's approval.",
+			"My house's alliance with another noble family must be sustained.",
+			"I am in love with the heir of a rival family.",
+			"Nothing is more important than the other members of my family.",
+			"My loyalty to my sovereign is unwavering.",
+			"The common folk must see me as a hero of the people.",
+		},
+		Flaws: []string{
+			"I secretly believe that everyone is beneath me.",
+			"I hide a truly scandalous secret.",
+			"I too often hear veiled insults and threats in every word.",
+			"I often spend coin I do not have to keep up appearances.",
+			"I break my word whenever it suits the interests of my house.",
+			"By my rights as a noble, nobody dares to refuse me.",
+		},
+	},
+	"outlander": {
+		Name: "Outlander", Skills: []string{SkillAthletics, SkillSurvival}, Tools: []string{"one musical instrument (choose)"}, LanguageChoices: 1,
+		Equipment: []string{"staff", "hunting trap", "trophy from an animal you killed", "traveler's clothes", "belt pouch"},
+		Gold:      10,
+		Feature:   Trait{"Wanderer", "You recall maps and geography and can find food and water for yourself and five others."},
+		PersonalityTraits: []string{
+			"I am driven by a wanderlust that led me away from home.",
+			"I watch over my friends as if they were newborn pups.",
+			"I place no stock in wealthy or well-mannered folk.",
+			"I feel far more comfortable around animals than people.",
+			"I was, in fact, raised by wolves.",
+			"I once ran twenty-five miles without stopping to warn my clan of an approaching threat.",
+			"I have a lesson for every situation, drawn from observing nature.",
+			"I am always picking things up, absently fiddling with them and sometimes accidentally breaking them.",
+		},
+		Ideals: []string{
+			"Change: life is like the seasons, in constant change.",
+			"Nature: the natural world is more important than civilization.",
+			"Glory: I must earn glory in battle.",
+			"Honor: if I dishonor myself, I dishonor my whole clan.",
+			"Greater Good: it is each person's responsibility to make the most happiness for the whole tribe.",
+			"Tradition: the customs of my people must not be forgotten.",
+		},
+		Bonds: []string{
+			"My family, clan or tribe is the most important thing in my life.",
+			"An injury to the unspoiled wilderness is an injury to me.",
+			"I suffer awful visions of a coming disaster.",
+			"I will bring terrible wrath down on the evildoers who destroyed my homeland.",
+			"I am the last of my tribe, and it is up to me to ensure their names enter legend.",
+			"It is my duty to provide children to sustain my tribe.",
+		},
+		Flaws: []string{
+			"I am too enamored of ale, wine and other intoxicants.",
+			"There is no room for caution in a life lived to the fullest.",
+			"Violence is my answer to almost any challenge.",
+			"I remember every insult I have received and nurse a silent resentment toward anyone who has ever wronged me.",
+			"I am slow to trust members of other races, tribes and societies.",
+			"I sleep too deeply and wake far too slowly.",
+		},
+	},
+	"sage": {
+		Name: "Sage", Skills: []string{SkillArcana, SkillHistory}, LanguageChoices: 2,
+		Equipment: []string{"bottle of black ink", "quill", "small knife", "letter from a dead colleague", "common clothes", "belt pouch"},
+		Gold:      10,
+		Feature:   Trait{"Researcher", "When you do not know a piece of lore, you often know where and from whom to obtain it."},
+		PersonalityTraits: []string{
+			"I use polysyllabic words that convey the impression of great erudition.",
+			"I have read every book in the world's greatest libraries.",
+			"I am horribly awkward in social situations.",
+			"I am convinced that people are always trying to steal my secrets.",
+			"There is nothing I like more than a good mystery.",
+			"I am willing to listen to every side of an argument before I make my own judgment.",
+			"I speak slowly when talking to idiots, which almost everyone is compared to me.",
+			"I keep careful notes about everything, even things that do not matter.",
+		},
+		Ideals: []string{
+			"Knowledge: the path to power is through knowledge.",
+			"Beauty: what is beautiful points us beyond itself.",
+			"Logic: emotions must not cloud our thinking.",
+			"No Limits: nothing should fetter the infinite possibility inherent in all existence.",
+			"Power: knowledge is the path to power and domination.",
+			"Self-Improvement: the goal of a life of study is the betterment of oneself.",
+		},
+		Bonds: []string{
+			"It is my duty to protect my students.",
+			"I have an ancient text that holds terrible secrets.",
+			"I have sought a certain answer my whole life.",
+			"I work to preserve a library, university, scriptorium or monastery.",
+			"My life's work is a series of tomes related to a specific field of lore.",
+			"I sold my soul for knowledge, and I hope to do great deeds to win it back.",
+		},
+		Flaws: []string{
+			"I am easily distracted by the promise of information.",
+			"I overlook obvious solutions in favor of complicated ones.",
+			"Unlocking an ancient mystery is worth the price of a civilization.",
+			"I speak without really thinking through my words, invariably insulting others.",
+			"I cannot keep a secret to save my life, or anyone else's.",
+			"I dismiss anyone who has not read the books I have read.",
+		},
+	},
+	"sailor": {
+		Name: "Sailor", Skills: []string{SkillAthletics, SkillPerception}, Tools: []string{"navigator's tools", "vehicles (water)"},
+		Equipment: []string{"belaying pin (club)", "50 feet of silk rope", "lucky charm", "common clothes", "belt pouch"},
+		Gold:      10,
+		Feature:   Trait{"Ship's Passage", "You can secure free passage on a sailing ship for yourself and your companions."},
+		PersonalityTraits: []string{
+			"My friends know they can rely on me, no matter what.",
+			"I work hard so that I can play hard when the work is done.",
+			"I enjoy sailing into new ports and making new friends over a flagon of ale.",
+			"I stretch the truth for the sake of a good story.",
+			"I am a habitual liar about my past voyages.",
+			"Rough language and crude jokes are my native tongue.",
+			"I like a job well done, especially if I can convince someone else to do it.",
+			"I keep a weather eye on the sky at all times.",
+		},
+		Ideals: []string{
+			"Respect: the thing that keeps a ship together is mutual respect.",
+			"Freedom: the sea is freedom.",
+			"Mastery: I am a predator and the other ships are my prey.",
+			"Fairness: we all do the work, so we all share in the rewards.",
+			"Greed: I am in it for the money.",
+			"People: I am committed to my crewmates, not to ideals.",
+		},
+		Bonds: []string{
+			"I am loyal to my captain first, everything else second.",
+			"The ship is most important; crewmates and captains come and go.",
+			"I will always remember my first ship.",
+			"In a harbor town, I have a paramour whose eyes nearly stole me from the sea.",
+			"I was cheated out of my fair share of the profits, and I want to get my due.",
+			"Ruthless pirates murdered my captain and crewmates, and I want revenge.",
+		},
+		Flaws: []string{
+			"I follow orders, even if I think they are wrong.",
+			"I will say anything to avoid extra work.",
+			"Once someone questions my courage, I never back down.",
+			"Once I start drinking, it is hard for me to stop.",
+			"I cannot help but pocket loose coins and other trinkets I come across.",
+			"My pride will probably lead to my destruction.",
+		},
+	},
+	"soldier": {
+		Name: "Soldier", Skills: []string{SkillAthletics, SkillIntimidation}, Tools: []string{"one gaming set (choose)", "vehicles (land)"},
+		Equipment: []string{"insignia of rank", "trophy taken from a fallen enemy", "deck of cards", "common clothes", "belt pouch"},
+		Gold:      10,
+		Feature:   Trait{"Military Rank", "Soldiers loyal to your former organization recognize your authority and influence."},
+		PersonalityTraits: []string{
+			"I am always polite and respectful.",
+			"I am haunted by memories of war.",
+			"I can stare down a hell hound without flinching.",
+			"I face problems head-on; a simple, direct solution is best.",
+			"I have a crude sense of humor.",
+			"I enjoy being strong and like breaking things.",
+			"I have lost too many friends, and I am slow to make new ones.",
+			"I have a story from the war for every occasion.",
+		},
+		Ideals: []string{
+			"Greater Good: our lot is to lay down our lives in defense of others.",
+			"Responsibility: I do what I must and obey just authority.",
+			"Might: in life as in war, the stronger force wins.",
+			"Honor: I do not lie or cheat in battle or out of it.",
+			"Independence: when people follow orders blindly, they embrace a kind of tyranny.",
+			"Nation: my city, nation or people are all that matter.",
+		},
+		Bonds: []string{
+			"I would still lay down my life for the people I served with.",
+			"Someone saved my life on the battlefield.",
+			"My honor is my life.",
+			"I fight for those who cannot fight for themselves.",
+			"I will never forget the crushing defeat my company suffered or the enemies who dealt it.",
+			"I carry the banner of a fallen comrade and will see it home.",
+		},
+		Flaws: []string{
+			"The monstrous enemy we faced in battle still leaves me quivering with fear.",
+			"I have little respect for anyone who is not a proven warrior.",
+			"I obey the law, even if the law causes misery.",
+			"I made a terrible mistake in battle that cost many lives, and I would do anything to keep it secret.",
+			"My hatred of my enemies is blind and unreasoning.",
+			"I would rather eat my armor than admit when I am wrong.",
+		},
+	},
+	"urchin": {
+		Name: "Urchin", Skills: []string{SkillSleightOfHand, SkillStealth}, Tools: []string{"disguise kit", "thieves' tools"},
+		Equipment: []string{"small knife", "map of your home city", "pet mouse", "token of your parents", "common clothes", "belt pouch"},
+		Gold:      10,
+		Feature:   Trait{"City Secrets", "You know the secret patterns of cities and can move through them twice as fast."},
+		PersonalityTraits: []string{
+			"I hide scraps of food and trinkets away in my pockets.",
+			"I ask a lot of questions.",
+			"I like to squeeze into small places where no one else can get to me.",
+			"I bluntly refuse to acknowledge danger.",
+			"I sleep with my back to a wall or tree, with everything I own wrapped in a bundle in my arms.",
+			"I eat like a pig and have bad manners.",
+			"I think anyone who is nice to me is hiding evil intent.",
+			"I do not like to bathe.",
+		},
+		Ideals: []string{
+			"Respect: all people, rich or poor, deserve respect.",
+			"Community: we have to take care of each other.",
+			"Change: the low are lifted up, and the high and mighty are brought down.",
+			"Retribution: the rich need to be shown what life and death are like in the gutters.",
+			"People: I help the people who help me, that is what keeps us alive.",
+			"Aspiration: I am going to prove that I am worthy of a better life.",
+		},
+		Bonds: []string{
+			"My town or city is my home, and I will fight to defend it.",
+			"I sponsor an orphanage to keep others from enduring what I did.",
+			"I owe my survival to another urchin who taught me to live on the streets.",
+			"I escaped my life of poverty by robbing an important person, and I am wanted for it.",
+			"No one else should have to endure the hardships I have been through.",
+			"I will repay the baker who fed me when no one else would.",
+		},
+		Flaws: []string{
+			"If I am outnumbered, I will run away from a fight.",
+			"Gold seems like a lot of money to me, and I will do just about anything for more of it.",
+			"I will never fully trust anyone other than myself.",
+			"It is not stealing if I need it more than someone else.",
+			"People who cannot take care of themselves get what they deserve.",
+			"I hoard food and coin long after I need to.",
+		},
+	},
+}
+
+func GetBackground(name string) (BackgroundData, bool) {
+	data, ok := backgroundRegistry[strings.ToLower(strings.TrimSpace(name))]
+	return data, ok
+}
+
+func (c *Character) BackgroundData() (BackgroundData, bool) {
+	if c.CustomBackground != nil {
+		return *c.CustomBackground, true
+	}
+	return GetBackground(c.Background)
+}
+
+func RollPersonality(background BackgroundData, seed int64) Profile {
+	r := rand.New(rand.NewSource(seed))
+	profile := Profile{TraitSeed: seed}
+	if n := len(background.PersonalityTraits); n > 0 {
+		for _, i := range r.Perm(n)[:min(personalityTraitCount, n)] {
+			profile.PersonalityTraits = append(profile.PersonalityTraits, background.PersonalityTraits[i])
+		}
+	}
+	pick := func(options []string) string {
+		if len(options) == 0 {
+			return ""
+		}
+		return options[r.Intn(len(options))]
+	}
+	profile.Ideal = pick(background.Ideals)
+	profile.Bond = pick(background.Bonds)
+	profile.Flaw = pick(background.Flaws)
+	return profile
+}
+
+type CustomBackgroundOptions struct {
+	Name      string
+	Feature   string
+	Skills    []string
+	Tools     []string
+	Languages int
+}
+
+func BuildCustomBackground(opts CustomBackgroundOptions) (BackgroundData, error) {
+	if strings.TrimSpace(opts.Name) == "" {
+		return BackgroundData{}, fmt.Errorf("custom background needs a name")
+	}
+	source, ok := GetBackground(opts.Feature)
+	if !ok {
+		return BackgroundData{}, fmt.Errorf("custom background feature: %w", newValidationError("background", opts.Feature, ValidBackgrounds()))
+	}
+
+	skills, err := NewSkillRepository().canonicalSkills(opts.Skills)
+	if err != nil {
+		return BackgroundData{}, err
+	}
+	if len(skills) != CustomBackgroundSkills {
+		return BackgroundData{}, fmt.Errorf("custom background grants %d skills of your choice, got %d", CustomBackgroundSkills, len(skills))
+	}
+	if opts.Languages < 0 {
+		return BackgroundData{}, fmt.Errorf("language count cannot be negative")
+	}
+	if total := len(opts.Tools) + opts.Languages; total != CustomBackgroundProficiency {
+		return BackgroundData{}, fmt.Errorf("custom background grants a total of %d tool proficiencies or languages, got %d", CustomBackgroundProficiency, total)
+	}
+
+	custom := source
+	custom.Name = strings.TrimSpace(opts.Name)
+	custom.Skills = skills
+	custom.Tools = append([]string{}, opts.Tools...)
+	custom.LanguageChoices = opts.Languages
+	return custom, nil
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type CastResult struct {
+	Spell     Spell
+	SlotLevel int
+	Pact      bool
+	Ritual    bool
+}
+
+func (r CastResult) Upcast() bool {
+	return r.SlotLevel > r.Spell.Level
+}
+
+func (c *Character) findSpell(name string) (Spell, bool) {
+	for _, s := range c.Spells {
+		if s.HasName(strings.TrimSpace(name)) {
+			return s, true
+		}
+	}
+	return Spell{}, false
+}
+
+func (c *Character) CastSpell(name string, level int) (CastResult, error) {
+	spell, ok := c.castableSpell(name)
+	if !ok {
+		if ritual, ok := c.ritualFromSpellbook(name); ok {
+			return CastResult{Spell: ritual, Ritual: true}, nil
+		}
+		return CastResult{}, fmt.Errorf("%s has not prepared or learned %s", c.Name, name)
+	}
+	result := CastResult{Spell: spell}
+	if spell.Level == 0 {
+		return result, nil
+	}
+	if level != 0 && level < spell.Level {
+		return result, fmt.Errorf("%s is a level %d spell and cannot be cast with a level %d slot", spell.Name, spell.Level, level)
+	}
+	if level > 9 {
+		return result, fmt.Errorf("spell slot level must be between 1 and 9, got %d", level)
+	}
+
+	if c.canUsePactSlot(spell, level) {
+		c.PactSlotsUsed++
+		result.SlotLevel = c.PactSlotLevel
+		result.Pact = true
+		return result, nil
+	}
+
+	remaining := c.SpellSlotsRemaining()
+	slot := level
+	if slot == 0 {
+		for lvl := spell.Level; lvl <= 9; lvl++ {
+			if remaining[lvl] > 0 {
+				slot = lvl
+				break
+			}
+		}
+	}
+	if slot == 0 || remaining[slot] == 0 {
+		target := max(level, spell.Level)
+		if level == 0 {
+			return result, fmt.Errorf("%s has no spell slots of level %d or higher left", c.Name, target)
+		}
+		return result, fmt.Errorf("%s has no level %d spell slots left", c.Name, target)
+	}
+
+	if c.SpellSlotsUsed == nil {
+		c.SpellSlotsUsed = map[int]int{}
+	}
+	c.SpellSlotsUsed[slot]++
+	result.SlotLevel = slot
+	return result, nil
+}
+
+func (c *Character) canUsePactSlot(spell Spell, level int) bool {
+	if c.PactSlotsRemaining() == 0 || spell.Level > c.PactSlotLevel {
+		return false
+	}
+	if level != 0 && level != c.PactSlotLevel {
+		return false
+	}
+	if containsFold(spell.Class, "warlock") {
+		return true
+	}
+	remaining := c.SpellSlotsRemaining()
+	for lvl := max(level, spell.Level); lvl <= 9; lvl++ {
+		if remaining[lvl] > 0 && (level == 0 || lvl == level) {
+			return false
+		}
+	}
+	return true
+}
@@ -25,20 +25,55 @@ type Character struct {
 	Name                string
 	Race                Race
 	Class               Class
+	Classes             []ClassLevel `json:"classes,omitempty"`
 	Background          string
+	CustomBackground    *BackgroundData `json:"custom_background,omitempty"`
+	Profile             Profile         `json:"profile"`
 	Level               int
+	Experience          int    `json:"experience,omitempty"`
+	Advancement         string `json:"advancement,omitempty"`
 	AbilityScores       AbilityScores
+	AbilityMethod       string         `json:"ability_method,omitempty"`
+	AbilitySeed         int64          `json:"ability_seed,omitempty"`
+	RacialASI           map[string]int `json:"racial_asi,omitempty"`
 	SkillProficiencies  []string
+	SkillExpertise      []string        `json:"skill_expertise,omitempty"`
+	Feats               []CharacterFeat `json:"feats,omitempty"`
 	ProficiencyBonus    int
 	Equipment           Equipment
+	Inventory           []string `json:"inventory,omitempty"`
+	Currency            Currency `json:"currency"`
 	Spells              []Spell
+	PreparedSpells      []Spell     `json:"prepared_spells,omitempty"`
+	Spellbook           []Spell     `json:"spellbook,omitempty"`
+	SpellbookCopies     int         `json:"spellbook_copies,omitempty"`
+	SpellSwapAvailable  bool        `json:"spell_swap_available,omitempty"`
 	SpellSlots          map[int]int `json:"spell_slots,omitempty"`
+	PactSlots           int         `json:"pact_slots,omitempty"`
+	PactSlotLevel       int         `json:"pact_slot_level,omitempty"`
+	SpellSlotsUsed      map[int]int `json:"spell_slots_used,omitempty"`
+	PactSlotsUsed       int         `json:"pact_slots_used,omitempty"`
 	SpellcastingAbility string
 	SpellSaveDC         int
 	SpellAttackBonus    int
-	ArmorClass          int `json:"armor_class,omitempty"`
-	Initiative          int `json:"initiative,omitempty"`
-	PassivePerception   int `json:"passive_perception,omitempty"`
+	ArmorClass          int            `json:"armor_class,omitempty"`
+	Initiative          int            `json:"initiative,omitempty"`
+	PassivePerception   int            `json:"passive_perception,omitempty"`
+	MaxHP               int            `json:"max_hp,omitempty"`
+	CurrentHP           int            `json:"current_hp"`
+	TempHP              int            `json:"temp_hp,omitempty"`
+	HitDiceSpent        map[int]int    `json:"hit_dice_spent,omitempty"`
+	HitPointRolls       []int          `json:"hit_point_rolls,omitempty"`
+	Conditions          []string       `json:"conditions,omitempty"`
+	Exhaustion          int            `json:"exhaustion,omitempty"`
+	ResourcesUsed       map[string]int `json:"resources_used,omitempty"`
+	Size                string         `json:"size,omitempty"`
+	Speed               int            `json:"speed,omitempty"`
+	BaseSpeed           int            `json:"base_speed,omitempty"`
+	Darkvision          int            `json:"darkvision,omitempty"`
+	Languages           []string       `json:"languages,omitempty"`
+	Resistances         []string       `json:"resistances,omitempty"`
+	RacialTraits        []string       `json:"racial_traits,omitempty"`
 }
 
 type Equipment struct {
@@ -72,14 +107,19 @@ func NewCharacterFactory() *CharacterFactory {
 }
 
 type CharacterParams struct {
-	ID         string
-	Name       string
-	Race       Race
-	Class      Class
-	Level      int
-	Ability    AbilityScores
-	Background string
-	Skills     []string
+	ID               string
+	Name             string
+	Race             Race
+	Class            Class
+	Level            int
+	Ability          AbilityScores
+	Method           string
+	Seed             int64
+	Background       string
+	Skills           []string
+	Profile          Profile
+	CustomBackground *BackgroundData
+	RacialASI        map[string]int
 }
 
 func (f *CharacterFactory) Create(params CharacterParams) (*Character, error) {
@@ -90,25 +130,35 @@ func (f *CharacterFactory) Create(params CharacterParams) (*Character, error) {
 		return nil, fmt.Errorf("level cannot be lower than 1")
 	}
 
-	racialBonuses := GetRacialBonuses(params.Race)
-	params.Ability.Str += racialBonuses["Str"]
-	params.Ability.Dex += racialBonuses["Dex"]
-	params.Ability.Con += racialBonuses["Con"]
-	params.Ability.Int += racialBonuses["Int"]
-	params.Ability.Wis += racialBonuses["Wis"]
-	params.Ability.Cha += racialBonuses["Cha"]
+	racialBonuses := params.RacialASI
+	if racialBonuses == nil {
+		racialBonuses = GetRacialBonuses(params.Race)
+	}
+	for ability, bonus := range racialBonuses {
+		params.Ability.add(ability, bonus)
+	}
 
 	char := &Character{
 		ID:                 params.ID,
 		Name:               params.Name,
 		Race:               params.Race,
 		Class:              params.Class,
+		Classes:            []ClassLevel{{Class: params.Class, Level: params.Level}},
 		Level:              params.Level,
 		AbilityScores:      params.Ability,
+		AbilityMethod:      params.Method,
+		AbilitySeed:        params.Seed,
+		RacialASI:          params.RacialASI,
 		Background:         params.Background,
-		SkillProficiencies: params.Skills,
+		CustomBackground:   params.CustomBackground,
+		Profile:            params.Profile,
+		SkillProficiencies: withRacialSkills(params.Race, params.Skills),
 		ProficiencyBonus:   CalculateProficiencyBonus(params.Level),
 	}
+	if background, ok := char.BackgroundData(); ok {
+		char.Inventory = append([]string{}, background.Equipment...)
+		char.Currency = Currency{GP: background.Gold}
+	}
 
 	char.UpdateStats()
 
@@ -116,55 +166,71 @@ func (f *CharacterFactory) Create(params CharacterParams) (*Character, error) {
 }
 
 func (c *Character) UpdateStats() {
+	c.MigrateClasses()
+	c.migratePreparedSpells()
+	c.migrateSpellbook()
+	c.applyRace()
+	c.ProficiencyBonus = CalculateProficiencyBonus(c.Level)
 	c.Initiative = Modifier(c.AbilityScores.Dex)
+	speed := c.BaseSpeed
+	for _, feat := range c.featData() {
+		c.Initiative += feat.InitiativeBonus
+		speed += feat.SpeedBonus
+	}
+	c.Speed = c.conditionSpeed(speed)
 	c.ArmorClass = c.CalculateArmorClass()
-	c.PassivePerception = 10 + Modifier(c.AbilityScores.Wis)
+	c.PassivePerception = c.PassiveScore(SkillPerception)
+	c.updateHitPoints()
 	c.UpdateSpellcasting()
 }
 
 func (c *Character) UpdateSpellcasting() {
-	if !IsSpellcastingClass(string(c.Class)) {
+	caster := c.primarySpellcastingClass()
+	if caster == "" {
 		c.SpellSlots = nil
+		c.PactSlots = 0
+		c.PactSlotLevel = 0
 		c.SpellcastingAbility = ""
 		c.SpellSaveDC = 0
 		c.SpellAttackBonus = 0
 		return
 	}
 
-	var ability string
-	switch strings.ToLower(string(c.Class)) {
-	case "wizard":
-		ability = "INT"
-	case "cleric", "druid", "ranger":
-		ability = "WIS"
-	case "paladin", "sorcerer", "bard", "warlock":
-		ability = "CHA"
-	default:
-		ability = "INT"
-	}
-
+	ability := SpellcastingAbility(caster)
 	c.SpellcastingAbility = ability
-
-	var mod int
-	switch ability {
-	case "STR":
-		mod = Modifier(c.AbilityScores.Str)
-	case "DEX":
-		mod = Modifier(c.AbilityScores.Dex)
-	case "CON":
-		mod = Modifier(c.AbilityScores.Con)
-	case "INT":
-		mod = Modifier(c.AbilityScores.Int)
-	case "WIS":
-		mod = Modifier(c.AbilityScores.Wis)
-	case "CHA":
-		mod = Modifier(c.AbilityScores.Cha)
-	}
+	mod := Modifier(c.AbilityScores.Get(ability))
 
 	c.SpellSaveDC = 8 + c.ProficiencyBonus + mod
 	c.SpellAttackBonus = c.ProficiencyBonus + mod
 
-	c.SpellSlots = GetSpellSlots(c.Class, c.Level)
+	c.SpellSlots = GetMulticlassSpellSlots(c.ClassLevels())
+	c.PactSlotLevel, c.PactSlots = GetPactSlots(c.ClassLevel("warlock"))
+}
+
+func (c *Character) primarySpellcastingClass() Class {
+	for _, cl := range c.ClassLevels() {
+		if IsSpellcastingClass(string(cl.Class)) {
+			return cl.Class
+		}
+	}
+	return ""
+}
+
+func (c *Character) IsSpellcaster() bool {
+	return c.primarySpellcastingClass() != ""
+}
+
+func SpellcastingAbility(class Class) string {
+	switch strings.ToLower(string(class)) {
+	case "wizard":
+		return "INT"
+	case "cleric", "druid", "ranger":
+		return "WIS"
+	case "paladin", "sorcerer", "bard", "warlock":
+		return "CHA"
+	default:
+		return "INT"
+	}
 }
 
 func (c *Character) CalculateArmorClass() int {
@@ -207,14 +273,7 @@ func (c *Character) CalculateArmorClass() int {
 			}
 		}
 	} else {
-		switch strings.ToLower(string(c.Class)) {
-		case "barbarian":
-			baseAC = 10 + dexMod + Modifier(c.AbilityScores.Con)
-		case "monk":
-			baseAC = 10 + dexMod + Modifier(c.AbilityScores.Wis)
-		default:
-			baseAC = 10 + dexMod
-		}
+		baseAC = c.unarmoredArmorClass()
 	}
 
 	if c.Equipment.Shield != nil {
@@ -225,54 +284,20 @@ func (c *Character) CalculateArmorClass() int {
 }
 
 func GetRacialBonuses(race Race) map[string]int {
-	switch strings.ToLower(string(race)) {
-	case "human":
-		return map[string]int{"Str": 1, "Dex": 1, "Con": 1, "Int": 1, "Wis": 1, "Cha": 1}
-	case "variant human":
-		return map[string]int{"Str": 1, "Dex": 1}
-	case "hill dwarf":
-		return map[string]int{"Con": 2, "Wis": 1}
-	case "dwarf":
-		return map[string]int{"Con": 2}
-	case "dwarf mountain":
-		return map[string]int{"Con": 2, "Str": 2}
-	case "elf high":
-		return map[string]int{"Dex": 2, "Int": 1}
-	case "elf wood":
-		return map[string]int{"Dex": 2, "Wis": 1}
-	case "elf drow":
-		return map[string]int{"Dex": 2, "Cha": 1}
-	case "lightfoot halfling":
-		return map[string]int{"Dex": 2, "Cha": 1}
-	case "stout halfling":
-		return map[string]int{"Dex": 2, "Con": 1}
-	case "dragonborn":
-		return map[string]int{"Str": 2, "Cha": 1}
-	case "gnome forest":
-		return map[string]int{"Int": 2, "Dex": 1}
-	case "gnome rock":
-		return map[string]int{"Int": 2, "Con": 1}
-	case "gnome":
-		return map[string]int{"Int": 2}
-	case "half elf":
-		return map[string]int{"Cha": 2, "Con": 1, "Dex": 1}
-	case "half orc":
-		return map[string]int{"Str": 2, "Con": 1}
-	case "tiefling":
-		return map[string]int{"Cha": 2, "Int": 1}
-	case "aasimar":
-		return map[string]int{"Cha": 2, "Con": 1}
-	case "firbolg":
-		return map[string]int{"Wis": 2, "Str": 1}
-	case "goliath":
-		return map[string]int{"Str": 2, "Con": 1}
-	case "tabaxi":
-		return map[string]int{"Dex": 2, "Cha": 1}
-	case "triton":
-		return map[string]int{"Str": 1, "Con": 1, "Cha": 1}
-	default:
-		return map[string]int{}
+	bonuses := map[string]int{}
+	if data, ok := GetRace(race); ok {
+		for ability, bonus := range data.ASI {
+			bonuses[ability] = bonus
+		}
+	}
+	return bonuses
+}
+
+func (c *Character) RacialBonuses() map[string]int {
+	if c.RacialASI != nil {
+		return c.RacialASI
 	}
+	return GetRacialBonuses(c.Race)
 }
 
 func CalculateProficiencyBonus(level int) int {
@@ -305,39 +330,17 @@ func (c *Character) LearnSpell(spell Spell) error {
 			return fmt.Errorf("spell already known: %s", spell.Name)
 		}
 	}
-	c.Spells = append(c.Spells, spell)
-	return nil
-}
-
-func (c *Character) PrepareSpell(spell Spell) error {
-	if !PreparesSpells(string(c.Class)) {
-		return fmt.Errorf("this class cannot prepare spells")
-	}
-
-	slots := GetSpellSlots(c.Class, c.Level)
-	maxSlotLevel := 0
-	for lvl := range slots {
-		if lvl > maxSlotLevel {
-			maxSlotLevel = lvl
-		}
-	}
-
-	if spell.Level > maxSlotLevel {
-		return fmt.Errorf("the spell has higher level than the available spell slots")
+	cl, err := c.canLearn(spell)
+	if err != nil {
+		return err
 	}
-
-	if count, ok := slots[spell.Level]; !ok || count == 0 {
-		return fmt.Errorf("no slots available for this spell level")
+	if c.usesSpellbook(spell) {
+		return c.addToSpellbook(spell)
 	}
-
-	for _, s := range c.Spells {
-		if s.Name == spell.Name {
-			return fmt.Errorf("spell already prepared: %s", spell.Name)
-		}
+	if spell.Level > 0 && PreparesSpells(string(cl.Class)) {
+		return fmt.Errorf("%s prepares %s spells instead of learning them, use prepare-spell", c.Name, cl.Class)
 	}
-
 	c.Spells = append(c.Spells, spell)
-	c.UpdateStats()
 	return nil
 }
 
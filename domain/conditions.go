@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	ConditionExhaustion = "exhaustion"
+	MaxExhaustion       = 6
+	walkingSpeed        = 30
+)
+
+type ConditionData struct {
+	Name              string
+	Summary           string
+	SpeedZero         bool
+	CheckDisadvantage bool
+}
+
+var conditionRegistry = map[string]ConditionData{
+	"blinded": {Name: "Blinded",
+		Summary: "Can't see and automatically fails checks that require sight. Attacks against it have advantage, its attacks have disadvantage."},
+	"charmed": {Name: "Charmed",
+		Summary: "Can't attack the charmer or target it with harmful effects. The charmer has advantage on social checks against it."},
+	"deafened": {Name: "Deafened",
+		Summary: "Can't hear and automatically fails checks that require hearing."},
+	"frightened": {Name: "Frightened",
+		Summary: "Disadvantage on ability checks and attack rolls while the source of fear is in sight. Can't willingly move closer to the source."},
+	"grappled": {Name: "Grappled", SpeedZero: true,
+		Summary: "Speed becomes 0 and can't benefit from bonuses to speed."},
+	"incapacitated": {Name: "Incapacitated",
+		Summary: "Can't take actions or reactions."},
+	"invisible": {Name: "Invisible",
+		Summary: "Impossible to see without magic or a special sense. Attacks against it have disadvantage, its attacks have advantage."},
+	"paralyzed": {Name: "Paralyzed", SpeedZero: true,
+		Summary: "Incapacitated, can't move or speak. Automatically fails STR and DEX saves. Attacks against it have advantage and hits within 5 ft are critical."},
+	"petrified": {Name: "Petrified", SpeedZero: true,
+		Summary: "Transformed into stone and incapacitated. Resistance to all damage, immune to poison and disease. Automatically fails STR and DEX saves."},
+	"poisoned": {Name: "Poisoned", CheckDisadvantage: true,
+		Summary: "Disadvantage on attack rolls and ability checks."},
+	"prone": {Name: "Prone",
+		Summary: "Can only crawl unless it stands up. Disadvantage on attack rolls. Attacks within 5 ft have advantage, others have disadvantage."},
+	"restrained": {Name: "Restrained", SpeedZero: true,
+		Summary: "Speed becomes 0. Disadvantage on attack rolls and DEX saves. Attacks against it have advantage."},
+	"stunned": {Name: "Stunned", SpeedZero: true,
+		Summary: "Incapacitated, can't move and can speak only falteringly. Automatically fails STR and DEX saves. Attacks against it have advantage."},
+	"unconscious": {Name: "Unconscious", SpeedZero: true,
+		Summary: "Incapacitated, can't move or speak and is unaware of its surroundings. Drops what it's holding and falls prone. Attacks against it have advantage and hits within 5 ft are critical."},
+}
+
+var exhaustionEffects = []string{
+	"Disadvantage on ability checks",
+	"Speed halved",
+	"Disadvantage on attack rolls and saving throws",
+	"Hit point maximum halved",
+	"Speed reduced to 0",
+	"Death",
+}
+
+func GetCondition(name string) (ConditionData, bool) {
+	data, ok := conditionRegistry[strings.ToLower(strings.TrimSpace(name))]
+	return data, ok
+}
+
+func ValidConditions() []string {
+	names := make([]string, 0, len(conditionRegistry)+1)
+	for key := range conditionRegistry {
+		names = append(names, key)
+	}
+	names = append(names, ConditionExhaustion)
+	sort.Strings(names)
+	return names
+}
+
+func ExhaustionSummary(level int) string {
+	level = min(max(level, 0), MaxExhaustion)
+	return strings.Join(exhaustionEffects[:level], "; ")
+}
+
+func (c *Character) HasCondition(name string) bool {
+	return containsFold(c.Conditions, name)
+}
+
+func (c *Character) AddCondition(name string) (string, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if key == ConditionExhaustion {
+		return c.SetExhaustion(c.Exhaustion + 1)
+	}
+	data, ok := GetCondition(key)
+	if !ok {
+		return "", newValidationError("condition", name, ValidConditions())
+	}
+	if c.HasCondition(data.Name) {
+		return "", fmt.Errorf("%s is already %s", c.Name, strings.ToLower(data.Name))
+	}
+	c.Conditions = append(c.Conditions, data.Name)
+	return data.Name, nil
+}
+
+func (c *Character) RemoveCondition(name string) (string, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if key == ConditionExhaustion {
+		if c.Exhaustion == 0 {
+			return "", fmt.Errorf("%s has no exhaustion", c.Name)
+		}
+		return c.SetExhaustion(c.Exhaustion - 1)
+	}
+	data, ok := GetCondition(key)
+	if !ok {
+		return "", newValidationError("condition", name, ValidConditions())
+	}
+	for i, condition := range c.Conditions {
+		if strings.EqualFold(condition, data.Name) {
+			c.Conditions = append(c.Conditions[:i], c.Conditions[i+1:]...)
+			return data.Name, nil
+		}
+	}
+	return "", fmt.Errorf("%s is not %s", c.Name, strings.ToLower(data.Name))
+}
+
+func (c *Character) SetExhaustion(level int) (string, error) {
+	if level < 0 || level > MaxExhaustion {
+		return "", fmt.Errorf("exhaustion level must be between 0 and %d, got %d", MaxExhaustion, level)
+	}
+	c.Exhaustion = level
+	if c.MaxHP > 0 {
+		c.MaxHP = c.hitPointMaximum()
+		c.CurrentHP = min(c.CurrentHP, c.MaxHP)
+	}
+	return fmt.Sprintf("Exhaustion %d", level), nil
+}
+
+func (c *Character) ActiveConditions() []ConditionData {
+	var active []ConditionData
+	for _, name := range c.Conditions {
+		if data, ok := GetCondition(name); ok {
+			active = append(active, data)
+		}
+	}
+	if c.Exhaustion > 0 {
+		active = append(active, ConditionData{
+			Name:    fmt.Sprintf("Exhaustion %d", c.Exhaustion),
+			Summary: ExhaustionSummary(c.Exhaustion),
+		})
+	}
+	return active
+}
+
+func (c *Character) HasCheckDisadvantage() bool {
+	if c.Exhaustion >= 1 {
+		return true
+	}
+	for _, condition := range c.ActiveConditions() {
+		if condition.CheckDisadvantage {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Character) conditionSpeed(speed int) int {
+	if c.Exhaustion >= 5 {
+		return 0
+	}
+	for _, condition := range c.ActiveConditions() {
+		if condition.SpeedZero {
+			return 0
+		}
+	}
+	if c.Exhaustion >= 2 {
+		return speed / 2
+	}
+	return speed
+}
+
+func (c *Character) hitPointMaximum() int {
+	maxHP := c.CalculateMaxHP()
+	if c.Exhaustion >= 4 {
+		maxHP /= 2
+	}
+	return maxHP
+}
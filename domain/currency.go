@@ -0,0 +1,66 @@
+package domain
+
+import "fmt"
+
+const (
+	copperPerSP = 10
+	copperPerEP = 50
+	copperPerGP = 100
+	copperPerPP = 1000
+)
+
+type Currency struct {
+	CP int `json:"cp,omitempty"`
+	SP int `json:"sp,omitempty"`
+	EP int `json:"ep,omitempty"`
+	GP int `json:"gp,omitempty"`
+	PP int `json:"pp,omitempty"`
+}
+
+type coinPurse struct {
+	count *int
+	value int
+}
+
+func (cur *Currency) coins() []coinPurse {
+	return []coinPurse{{&cur.CP, 1}, {&cur.SP, copperPerSP}, {&cur.EP, copperPerEP}, {&cur.GP, copperPerGP}, {&cur.PP, copperPerPP}}
+}
+
+func (cur Currency) TotalCP() int {
+	return cur.CP + cur.SP*copperPerSP + cur.EP*copperPerEP + cur.GP*copperPerGP + cur.PP*copperPerPP
+}
+
+func (cur *Currency) SpendGP(gp int) error {
+	cost := gp * copperPerGP
+	if total := cur.TotalCP(); total < cost {
+		return fmt.Errorf("costs %d gp but only %d gp worth of coins are available", gp, total/copperPerGP)
+	}
+
+	paid := min(cur.GP, cost/copperPerGP)
+	cur.GP -= paid
+	remaining := cost - paid*copperPerGP
+	for _, coin := range cur.coins() {
+		n := min(*coin.count, remaining/coin.value)
+		*coin.count -= n
+		remaining -= n * coin.value
+	}
+	if remaining == 0 {
+		return nil
+	}
+
+	for _, coin := range cur.coins() {
+		if *coin.count > 0 {
+			*coin.count--
+			cur.addChange(coin.value - remaining)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (cur *Currency) addChange(copper int) {
+	cur.GP += copper / copperPerGP
+	copper %= copperPerGP
+	cur.SP += copper / copperPerSP
+	cur.CP += copper % copperPerSP
+}
@@ -0,0 +1,181 @@
+package domain
+
+import "strings"
+
+const (
+	ArmorLight    = "light armor"
+	ArmorMedium   = "medium armor"
+	ArmorHeavy    = "heavy armor"
+	ArmorShields  = "shields"
+	WeaponSimple  = "simple weapons"
+	WeaponMartial = "martial weapons"
+)
+
+type ClassProficiencies struct {
+	Armor   []string
+	Weapons []string
+	Tools   []string
+}
+
+var classProficiencies = map[string]ClassProficiencies{
+	"barbarian": {Armor: []string{ArmorLight, ArmorMedium, ArmorShields}, Weapons: []string{WeaponSimple, WeaponMartial}},
+	"bard": {Armor: []string{ArmorLight}, Weapons: []string{WeaponSimple, "hand crossbow", "longsword", "rapier", "shortsword"},
+		Tools: []string{"three musical instruments (choose)"}},
+	"cleric": {Armor: []string{ArmorLight, ArmorMedium, ArmorShields}, Weapons: []string{WeaponSimple}},
+	"druid": {Armor: []string{ArmorLight, ArmorMedium, ArmorShields},
+		Weapons: []string{"club", "dagger", "dart", "javelin", "mace", "quarterstaff", "scimitar", "sickle", "sling", "spear"},
+		Tools:   []string{"herbalism kit"}},
+	"fighter": {Armor: []string{ArmorLight, ArmorMedium, ArmorHeavy, ArmorShields}, Weapons: []string{WeaponSimple, WeaponMartial}},
+	"monk":    {Weapons: []string{WeaponSimple, "shortsword"}, Tools: []string{"one artisan's tools or musical instrument (choose)"}},
+	"paladin": {Armor: []string{ArmorLight, ArmorMedium, ArmorHeavy, ArmorShields}, Weapons: []string{WeaponSimple, WeaponMartial}},
+	"ranger":  {Armor: []string{ArmorLight, ArmorMedium, ArmorShields}, Weapons: []string{WeaponSimple, WeaponMartial}},
+	"rogue": {Armor: []string{ArmorLight}, Weapons: []string{WeaponSimple, "hand crossbow", "longsword", "rapier", "shortsword"},
+		Tools: []string{"thieves' tools"}},
+	"sorcerer": {Weapons: []string{"dagger", "dart", "sling", "quarterstaff", "light crossbow"}},
+	"warlock":  {Armor: []string{ArmorLight}, Weapons: []string{WeaponSimple}},
+	"wizard":   {Weapons: []string{"dagger", "dart", "sling", "quarterstaff", "light crossbow"}},
+}
+
+var multiclassProficiencies = map[string]ClassProficiencies{
+	"barbarian": {Armor: []string{ArmorShields}, Weapons: []string{WeaponSimple, WeaponMartial}},
+	"bard":      {Armor: []string{ArmorLight}, Tools: []string{"one musical instrument (choose)"}},
+	"cleric":    {Armor: []string{ArmorLight, ArmorMedium, ArmorShields}},
+	"druid":     {Armor: []string{ArmorLight, ArmorMedium, ArmorShields}},
+	"fighter":   {Armor: []string{ArmorLight, ArmorMedium, ArmorShields}, Weapons: []string{WeaponSimple, WeaponMartial}},
+	"monk":      {Weapons: []string{WeaponSimple, "shortsword"}},
+	"paladin":   {Armor: []string{ArmorLight, ArmorMedium, ArmorShields}, Weapons: []string{WeaponSimple, WeaponMartial}},
+	"ranger":    {Armor: []string{ArmorLight, ArmorMedium, ArmorShields}, Weapons: []string{WeaponSimple, WeaponMartial}},
+	"rogue":     {Armor: []string{ArmorLight}, Tools: []string{"thieves' tools"}},
+	"warlock":   {Armor: []string{ArmorLight}, Weapons: []string{WeaponSimple}},
+}
+
+var armorCategories = map[string]string{
+	"padded":          ArmorLight,
+	"leather":         ArmorLight,
+	"studded leather": ArmorLight,
+	"hide":            ArmorMedium,
+	"chain shirt":     ArmorMedium,
+	"scale mail":      ArmorMedium,
+	"breastplate":     ArmorMedium,
+	"half plate":      ArmorMedium,
+	"ring mail":       ArmorHeavy,
+	"chain mail":      ArmorHeavy,
+	"splint":          ArmorHeavy,
+	"plate":           ArmorHeavy,
+}
+
+var weaponCategories = map[string]string{
+	"club": WeaponSimple, "dagger": WeaponSimple, "greatclub": WeaponSimple, "handaxe": WeaponSimple,
+	"javelin": WeaponSimple, "light hammer": WeaponSimple, "mace": WeaponSimple, "quarterstaff": WeaponSimple,
+	"sickle": WeaponSimple, "spear": WeaponSimple, "light crossbow": WeaponSimple, "dart": WeaponSimple,
+	"shortbow": WeaponSimple, "sling": WeaponSimple,
+	"battleaxe": WeaponMartial, "flail": WeaponMartial, "glaive": WeaponMartial, "greataxe": WeaponMartial,
+	"greatsword": WeaponMartial, "halberd": WeaponMartial, "lance": WeaponMartial, "longsword": WeaponMartial,
+	"maul": WeaponMartial, "morningstar": WeaponMartial, "pike": WeaponMartial, "rapier": WeaponMartial,
+	"scimitar": WeaponMartial, "shortsword": WeaponMartial, "trident": WeaponMartial, "war pick": WeaponMartial,
+	"warhammer": WeaponMartial, "whip": WeaponMartial, "blowgun": WeaponMartial, "hand crossbow": WeaponMartial,
+	"heavy crossbow": WeaponMartial, "longbow": WeaponMartial, "net": WeaponMartial,
+}
+
+func ArmorCategory(name string) string {
+	key := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), " armor")
+	return armorCategories[key]
+}
+
+func WeaponCategory(name string) string {
+	return weaponCategories[strings.ToLower(strings.TrimSpace(name))]
+}
+
+func (c *Character) classProficiencies() []ClassProficiencies {
+	var result []ClassProficiencies
+	for i, cl := range c.ClassLevels() {
+		table := multiclassProficiencies
+		if i == 0 {
+			table = classProficiencies
+		}
+		result = append(result, table[strings.ToLower(string(cl.Class))])
+	}
+	return result
+}
+
+func (c *Character) ArmorProficiencies() []string {
+	var armor []string
+	for _, p := range c.classProficiencies() {
+		armor = append(armor, p.Armor...)
+	}
+	for _, feat := range c.featData() {
+		armor = append(armor, feat.Armor...)
+	}
+	for _, f := range c.Features() {
+		armor = append(armor, f.Armor...)
+	}
+	race, _ := GetRace(c.Race)
+	return uniqueStrings(append(armor, race.ArmorProficiencies...))
+}
+
+func (c *Character) WeaponProficiencies() []string {
+	var weapons []string
+	for _, p := range c.classProficiencies() {
+		weapons = append(weapons, p.Weapons...)
+	}
+	race, _ := GetRace(c.Race)
+	return uniqueStrings(append(weapons, race.WeaponProficiencies...))
+}
+
+func (c *Character) ToolProficiencies() []string {
+	var tools []string
+	for _, p := range c.classProficiencies() {
+		tools = append(tools, p.Tools...)
+	}
+	race, _ := GetRace(c.Race)
+	tools = append(tools, race.ToolProficiencies...)
+	background, _ := c.BackgroundData()
+	tools = append(tools, background.Tools...)
+	return uniqueStrings(tools)
+}
+
+func (c *Character) LanguageChoices() int {
+	race, _ := GetRace(c.Race)
+	background, _ := c.BackgroundData()
+	return race.LanguageChoices + background.LanguageChoices
+}
+
+func (c *Character) IsProficientWithArmor(name string) bool {
+	category := ArmorCategory(name)
+	if category == "" {
+		return true
+	}
+	return containsFold(c.ArmorProficiencies(), category)
+}
+
+func (c *Character) IsProficientWithShield() bool {
+	return containsFold(c.ArmorProficiencies(), ArmorShields)
+}
+
+func (c *Character) IsProficientWithWeapon(name string) bool {
+	category := WeaponCategory(name)
+	if category == "" {
+		return true
+	}
+	proficiencies := c.WeaponProficiencies()
+	return containsFold(proficiencies, category) || containsFold(proficiencies, name)
+}
+
+func containsFold(list []string, value string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, strings.TrimSpace(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func uniqueStrings(values []string) []string {
+	var result []string
+	for _, v := range values {
+		if !containsFold(result, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
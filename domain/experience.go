@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	AdvancementXP        = "xp"
+	AdvancementMilestone = "milestone"
+)
+
+var xpThresholds = []int{
+	0, 300, 900, 2700, 6500, 14000, 23000, 34000, 48000, 64000,
+	85000, 100000, 120000, 140000, 165000, 195000, 225000, 265000, 305000, 355000,
+}
+
+func XPForLevel(level int) int {
+	if level < 1 {
+		return 0
+	}
+	if level > MaxLevel {
+		level = MaxLevel
+	}
+	return xpThresholds[level-1]
+}
+
+func LevelForXP(xp int) int {
+	level := 1
+	for i, threshold := range xpThresholds {
+		if xp >= threshold {
+			level = i + 1
+		}
+	}
+	return level
+}
+
+func (c *Character) UsesMilestones() bool {
+	return strings.EqualFold(c.Advancement, AdvancementMilestone)
+}
+
+func (c *Character) SetAdvancement(mode string) error {
+	switch strings.ToLower(mode) {
+	case AdvancementXP:
+		c.Advancement = ""
+	case AdvancementMilestone:
+		c.Advancement = AdvancementMilestone
+	default:
+		return fmt.Errorf("unknown advancement mode: %s (use %s or %s)", mode, AdvancementXP, AdvancementMilestone)
+	}
+	return nil
+}
+
+func (c *Character) AwardXP(amount int) error {
+	if c.UsesMilestones() {
+		return fmt.Errorf("%s uses milestone leveling, experience points are disabled", c.Name)
+	}
+	if amount < 0 {
+		return fmt.Errorf("experience points cannot be negative")
+	}
+	c.Experience += amount
+	return nil
+}
+
+func (c *Character) LevelUpAvailable() bool {
+	if c.UsesMilestones() || c.Level >= MaxLevel {
+		return false
+	}
+	return LevelForXP(c.Experience) > c.Level
+}
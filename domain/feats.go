@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type FeatPrerequisite struct {
+	Abilities    []string
+	MinScore     int
+	Spellcasting bool
+	Armor        string
+}
+
+type Feat struct {
+	Name            string
+	Description     string
+	Prerequisite    FeatPrerequisite
+	AbilityChoices  []string
+	InitiativeBonus int
+	HPPerLevel      int
+	SpeedBonus      int
+	PassiveBonus    map[string]int
+	SaveFromChoice  bool
+	Armor           []string
+	Repeatable      bool
+}
+
+type CharacterFeat struct {
+	Name    string
+	Ability string `json:"ability,omitempty"`
+}
+
+var featRegistry = map[string]Feat{
+	"alert": {Name: "Alert", Description: "+5 to initiative; you cannot be surprised while conscious and hidden attackers gain no advantage against you.",
+		InitiativeBonus: 5},
+	"actor": {Name: "Actor", Description: "Advantage on Deception and Performance checks to pass as someone else; mimic voices and sounds.",
+		AbilityChoices: []string{"CHA"}},
+	"athlete": {Name: "Athlete", Description: "Stand up and climb more easily and make running jumps after moving only 5 feet.",
+		AbilityChoices: []string{"STR", "DEX"}},
+	"defensive duelist": {Name: "Defensive Duelist", Description: "Reaction: add your proficiency bonus to AC against one melee attack while wielding a finesse weapon.",
+		Prerequisite: FeatPrerequisite{Abilities: []string{"DEX"}, MinScore: 13}},
+	"durable": {Name: "Durable", Description: "When you spend a hit die, regain at least twice your CON modifier.",
+		AbilityChoices: []string{"CON"}},
+	"grappler": {Name: "Grappler", Description: "Advantage on attacks against creatures you are grappling; you can try to pin a grappled creature.",
+		Prerequisite: FeatPrerequisite{Abilities: []string{"STR"}, MinScore: 13}},
+	"heavily armored": {Name: "Heavily Armored", Description: "Gain proficiency with heavy armor.",
+		Prerequisite: FeatPrerequisite{Armor: ArmorMedium}, AbilityChoices: []string{"STR"}, Armor: []string{ArmorHeavy}},
+	"inspiring leader": {Name: "Inspiring Leader", Description: "After a 10 minute speech, up to six allies gain temporary hit points equal to your level + CHA modifier.",
+		Prerequisite: FeatPrerequisite{Abilities: []string{"CHA"}, MinScore: 13}},
+	"lightly armored": {Name: "Lightly Armored", Description: "Gain proficiency with light armor.",
+		AbilityChoices: []string{"STR", "DEX"}, Armor: []string{ArmorLight}},
+	"lucky": {Name: "Lucky", Description: "Three luck points per long rest to roll an extra d20 on attacks, checks and saves."},
+	"mobile": {Name: "Mobile", Description: "Speed increases by 10 feet; Dash ignores difficult terrain and melee attacks prevent opportunity attacks from the target.",
+		SpeedBonus: 10},
+	"moderately armored": {Name: "Moderately Armored", Description: "Gain proficiency with medium armor and shields.",
+		Prerequisite: FeatPrerequisite{Armor: ArmorLight}, AbilityChoices: []string{"STR", "DEX"}, Armor: []string{ArmorMedium, ArmorShields}},
+	"observant": {Name: "Observant", Description: "Read lips and gain +5 to passive Perception and passive Investigation.",
+		AbilityChoices: []string{"INT", "WIS"}, PassiveBonus: map[string]int{SkillPerception: 5, SkillInvestigation: 5}},
+	"resilient": {Name: "Resilient", Description: "Gain proficiency in saving throws using the chosen ability.",
+		AbilityChoices: AbilityNames, SaveFromChoice: true, Repeatable: true},
+	"ritual caster": {Name: "Ritual Caster", Description: "Gain a ritual book with two 1st-level ritual spells and cast them as rituals.",
+		Prerequisite: FeatPrerequisite{Abilities: []string{"INT", "WIS"}, MinScore: 13}},
+	"sentinel": {Name: "Sentinel", Description: "Opportunity attacks reduce speed to 0 and you can attack creatures that attack your allies."},
+	"skulker": {Name: "Skulker", Description: "Hide when lightly obscured; missing with a ranged attack does not reveal your position.",
+		Prerequisite: FeatPrerequisite{Abilities: []string{"DEX"}, MinScore: 13}},
+	"tough": {Name: "Tough", Description: "Hit point maximum increases by 2 for every level.",
+		HPPerLevel: 2},
+	"war caster": {Name: "War Caster", Description: "Advantage on concentration saves, cast with full hands and cast spells as opportunity attacks.",
+		Prerequisite: FeatPrerequisite{Spellcasting: true}},
+}
+
+func GetFeat(name string) (Feat, bool) {
+	feat, ok := featRegistry[strings.ToLower(strings.TrimSpace(name))]
+	return feat, ok
+}
+
+func ValidFeats() []string {
+	feats := make([]string, 0, len(featRegistry))
+	for key := range featRegistry {
+		feats = append(feats, key)
+	}
+	sort.Strings(feats)
+	return feats
+}
+
+func (p FeatPrerequisite) String() string {
+	var parts []string
+	if len(p.Abilities) > 0 {
+		parts = append(parts, fmt.Sprintf("%s %d or higher", strings.Join(p.Abilities, " or "), p.MinScore))
+	}
+	if p.Spellcasting {
+		parts = append(parts, "the ability to cast at least one spell")
+	}
+	if p.Armor != "" {
+		parts = append(parts, fmt.Sprintf("proficiency with %s", p.Armor))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (c *Character) MeetsFeatPrerequisite(feat Feat) error {
+	p := feat.Prerequisite
+	if len(p.Abilities) > 0 {
+		met := false
+		for _, ability := range p.Abilities {
+			if c.AbilityScores.Get(ability) >= p.MinScore {
+				met = true
+			}
+		}
+		if !met {
+			return fmt.Errorf("%s requires %s", feat.Name, p)
+		}
+	}
+	if p.Spellcasting && !c.IsSpellcaster() {
+		return fmt.Errorf("%s requires %s", feat.Name, p)
+	}
+	if p.Armor != "" && !containsFold(c.ArmorProficiencies(), p.Armor) {
+		return fmt.Errorf("%s requires %s", feat.Name, p)
+	}
+	return nil
+}
+
+func (c *Character) HasFeat(name string) bool {
+	for _, f := range c.Feats {
+		if strings.EqualFold(f.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Character) featChoice(name, ability string) (Feat, CharacterFeat, error) {
+	feat, ok := GetFeat(name)
+	if !ok {
+		return Feat{}, CharacterFeat{}, newValidationError("feat", name, ValidFeats())
+	}
+	if err := c.MeetsFeatPrerequisite(feat); err != nil {
+		return Feat{}, CharacterFeat{}, err
+	}
+
+	choice := CharacterFeat{Name: feat.Name}
+	ability = strings.ToUpper(strings.TrimSpace(ability))
+	switch {
+	case len(feat.AbilityChoices) == 1 && ability == "":
+		choice.Ability = feat.AbilityChoices[0]
+	case len(feat.AbilityChoices) > 0:
+		if !containsFold(feat.AbilityChoices, ability) {
+			return Feat{}, CharacterFeat{}, fmt.Errorf("%s needs an ability choice: %s", feat.Name, strings.Join(feat.AbilityChoices, ", "))
+		}
+		choice.Ability = ability
+	case ability != "":
+		return Feat{}, CharacterFeat{}, fmt.Errorf("%s does not increase an ability score", feat.Name)
+	}
+
+	for _, taken := range c.Feats {
+		if !strings.EqualFold(taken.Name, feat.Name) {
+			continue
+		}
+		if !feat.Repeatable || taken.Ability == choice.Ability {
+			return Feat{}, CharacterFeat{}, fmt.Errorf("%s already has the %s feat", c.Name, feat.Name)
+		}
+	}
+	if choice.Ability != "" && c.AbilityScores.Get(choice.Ability)+1 > MaxAbilityScore {
+		return Feat{}, CharacterFeat{}, fmt.Errorf("%s cannot be increased above %d", choice.Ability, MaxAbilityScore)
+	}
+	return feat, choice, nil
+}
+
+func (c *Character) addFeat(choice CharacterFeat) {
+	c.Feats = append(c.Feats, choice)
+	if choice.Ability != "" {
+		c.AbilityScores.add(choice.Ability, 1)
+	}
+}
+
+func (c *Character) featData() []Feat {
+	var feats []Feat
+	for _, f := range c.Feats {
+		if feat, ok := GetFeat(f.Name); ok {
+			feats = append(feats, feat)
+		}
+	}
+	return feats
+}
+
+func (c *Character) featSavingThrows() []string {
+	var saves []string
+	for _, f := range c.Feats {
+		if feat, ok := GetFeat(f.Name); ok && feat.SaveFromChoice && f.Ability != "" {
+			saves = append(saves, f.Ability)
+		}
+	}
+	return saves
+}
@@ -0,0 +1,348 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type UnarmoredDefense struct {
+	Base         int
+	Ability      string
+	AllowsShield bool
+}
+
+type Feature struct {
+	Name             string
+	Level            int
+	Description      string
+	Progression      map[int]string
+	UnarmoredDefense *UnarmoredDefense
+	HPPerLevel       int
+	Expertise        map[int]int
+	HalfProficiency  bool
+	SavingThrows     []string
+	Armor            []string
+	Resource         *Resource
+}
+
+type CharacterFeature struct {
+	Feature
+	Class      Class
+	Source     string
+	ClassLevel int
+}
+
+func (f CharacterFeature) Detail() string {
+	best := 0
+	for lvl := range f.Progression {
+		if lvl <= f.ClassLevel && lvl > best {
+			best = lvl
+		}
+	}
+	return f.Progression[best]
+}
+
+func scaling(from, step int, detail func(level int) string) map[int]string {
+	progression := map[int]string{}
+	for lvl := from; lvl <= MaxLevel; lvl += step {
+		progression[lvl] = detail(lvl)
+	}
+	return progression
+}
+
+var classFeatures = map[string][]Feature{
+	"barbarian": {
+		{Name: "Rage", Level: 1, Description: "Bonus action: advantage on STR checks and saves, bonus melee damage, resistance to bludgeoning, piercing and slashing damage.",
+			Progression: map[int]string{1: "2 rages, +2 damage", 3: "3 rages, +2 damage", 6: "4 rages, +2 damage", 9: "4 rages, +3 damage", 12: "5 rages, +3 damage", 16: "5 rages, +4 damage", 17: "6 rages, +4 damage", 20: "unlimited rages, +4 damage"},
+			Resource:    &Resource{Uses: map[int]int{1: 2, 3: 3, 6: 4, 12: 5, 17: 6}, UnlimitedAt: 20, Recovery: RecoveryLongRest}},
+		{Name: "Unarmored Defense", Level: 1, Description: "Without armor, AC equals 10 + DEX modifier + CON modifier. A shield still applies.",
+			UnarmoredDefense: &UnarmoredDefense{Base: 10, Ability: "CON", AllowsShield: true}},
+		{Name: "Reckless Attack", Level: 2, Description: "Gain advantage on STR melee attacks this turn; attacks against you have advantage until your next turn."},
+		{Name: "Danger Sense", Level: 2, Description: "Advantage on DEX saves against effects you can see."},
+		{Name: "Extra Attack", Level: 5, Description: "Attack twice when you take the Attack action."},
+		{Name: "Fast Movement", Level: 5, Description: "Speed increases by 10 feet while not wearing heavy armor."},
+		{Name: "Feral Instinct", Level: 7, Description: "Advantage on initiative; can act while surprised if you rage first."},
+		{Name: "Brutal Critical", Level: 9, Description: "Roll additional weapon damage dice on melee critical hits.",
+			Progression: map[int]string{9: "1 extra die", 13: "2 extra dice", 17: "3 extra dice"}},
+		{Name: "Relentless Rage", Level: 11, Description: "While raging, a DC 10 CON save keeps you at 1 HP instead of dropping to 0; DC rises by 5 each use."},
+		{Name: "Persistent Rage", Level: 15, Description: "Rage only ends early if you fall unconscious or choose to end it."},
+		{Name: "Indomitable Might", Level: 18, Description: "A STR check total lower than your STR score can use the score instead."},
+		{Name: "Primal Champion", Level: 20, Description: "STR and CON increase by 4, to a maximum of 24."},
+	},
+	"bard": {
+		{Name: "Bardic Inspiration", Level: 1, Description: "Bonus action: give a creature an inspiration die to add to one check, attack or save. Uses equal CHA modifier.",
+			Progression: map[int]string{1: "d6", 5: "d8", 10: "d10", 15: "d12"},
+			Resource:    &Resource{Ability: "CHA", Recovery: RecoveryLongRest, ShortRestFrom: 5}},
+		{Name: "Jack of All Trades", Level: 2, Description: "Add half your proficiency bonus to ability checks you are not proficient in.",
+			HalfProficiency: true},
+		{Name: "Song of Rest", Level: 2, Description: "Allies who spend hit dice during a short rest regain extra hit points.",
+			Progression: map[int]string{2: "d6", 9: "d8", 13: "d10", 17: "d12"}},
+		{Name: "Expertise", Level: 3, Description: "Double proficiency bonus for two chosen skill proficiencies; two more at 10th level.",
+			Expertise: map[int]int{3: 2, 10: 4}},
+		{Name: "Font of Inspiration", Level: 5, Description: "Bardic Inspiration recovers on a short or long rest."},
+		{Name: "Countercharm", Level: 6, Description: "Performance grants nearby allies advantage on saves against being frightened or charmed."},
+		{Name: "Magical Secrets", Level: 10, Description: "Learn two spells from any class list; two more at 14th and 18th level."},
+		{Name: "Superior Inspiration", Level: 20, Description: "Regain one Bardic Inspiration when you roll initiative with none left."},
+	},
+	"cleric": {
+		{Name: "Channel Divinity", Level: 2, Description: "Channel divine energy for Turn Undead or a domain effect; recovers on a short or long rest.",
+			Progression: map[int]string{2: "1 use per rest", 6: "2 uses per rest", 18: "3 uses per rest"},
+			Resource:    &Resource{Uses: map[int]int{2: 1, 6: 2, 18: 3}, Recovery: RecoveryShortRest}},
+		{Name: "Turn Undead", Level: 2, Description: "Undead within 30 feet that fail a WIS save are turned for 1 minute."},
+		{Name: "Destroy Undead", Level: 5, Description: "Undead failing the Turn Undead save are destroyed below a challenge rating.",
+			Progression: map[int]string{5: "CR 1/2 or lower", 8: "CR 1 or lower", 11: "CR 2 or lower", 14: "CR 3 or lower", 17: "CR 4 or lower"}},
+		{Name: "Divine Intervention", Level: 10, Description: "Call on your deity for aid; succeeds on a d100 roll at or below your cleric level."},
+	},
+	"druid": {
+		{Name: "Druidic", Level: 1, Description: "You know the secret language of druids."},
+		{Name: "Wild Shape", Level: 2, Description: "Magically assume the shape of a beast twice per short or long rest.",
+			Progression: map[int]string{2: "max CR 1/4, no flying or swimming speed", 4: "max CR 1/2, no flying speed", 8: "max CR 1"},
+			Resource:    &Resource{Uses: map[int]int{2: 2}, UnlimitedAt: 20, Recovery: RecoveryShortRest}},
+		{Name: "Timeless Body", Level: 18, Description: "You age only 1 year for every 10 that pass."},
+		{Name: "Beast Spells", Level: 18, Description: "Cast spells with verbal and somatic components while in Wild Shape."},
+		{Name: "Archdruid", Level: 20, Description: "Unlimited Wild Shape; ignore verbal, somatic and non-costly material components."},
+	},
+	"fighter": {
+		{Name: "Fighting Style", Level: 1, Description: "Adopt a particular style of fighting as your specialty."},
+		{Name: "Second Wind", Level: 1, Description: "Bonus action: regain 1d10 + fighter level hit points once per short or long rest.",
+			Resource: &Resource{Uses: map[int]int{1: 1}, Recovery: RecoveryShortRest}},
+		{Name: "Action Surge", Level: 2, Description: "Take one additional action on your turn; recovers on a short or long rest.",
+			Progression: map[int]string{2: "1 use", 17: "2 uses"},
+			Resource:    &Resource{Uses: map[int]int{2: 1, 17: 2}, Recovery: RecoveryShortRest}},
+		{Name: "Extra Attack", Level: 5, Description: "Attack more than once when you take the Attack action.",
+			Progression: map[int]string{5: "2 attacks", 11: "3 attacks", 20: "4 attacks"}},
+		{Name: "Indomitable", Level: 9, Description: "Reroll a failed saving throw; recovers on a long rest.",
+			Progression: map[int]string{9: "1 use", 13: "2 uses", 17: "3 uses"},
+			Resource:    &Resource{Uses: map[int]int{9: 1, 13: 2, 17: 3}, Recovery: RecoveryLongRest}},
+	},
+	"monk": {
+		{Name: "Unarmored Defense", Level: 1, Description: "Without armor or shield, AC equals 10 + DEX modifier + WIS modifier.",
+			UnarmoredDefense: &UnarmoredDefense{Base: 10, Ability: "WIS"}},
+		{Name: "Martial Arts", Level: 1, Description: "Use DEX for unarmed strikes and monk weapons, roll a martial arts die for damage and make a bonus unarmed strike.",
+			Progression: map[int]string{1: "d4", 5: "d6", 11: "d8", 17: "d10"}},
+		{Name: "Ki", Level: 2, Description: "Spend ki points on Flurry of Blows, Patient Defense and Step of the Wind; recovers on a short or long rest.",
+			Progression: scaling(2, 1, func(level int) string { return fmt.Sprintf("%d ki points", level) }),
+			Resource:    &Resource{PerLevel: 1, Recovery: RecoveryShortRest}},
+		{Name: "Unarmored Movement", Level: 2, Description: "Speed increases while not wearing armor or wielding a shield.",
+			Progression: map[int]string{2: "+10 ft", 6: "+15 ft", 10: "+20 ft", 14: "+25 ft", 18: "+30 ft"}},
+		{Name: "Deflect Missiles", Level: 3, Description: "Reaction: reduce ranged weapon damage by 1d10 + DEX modifier + monk level."},
+		{Name: "Slow Fall", Level: 4, Description: "Reaction: reduce falling damage by five times your monk level."},
+		{Name: "Extra Attack", Level: 5, Description: "Attack twice when you take the Attack action."},
+		{Name: "Stunning Strike", Level: 5, Description: "Spend 1 ki point after a melee hit; the target must succeed on a CON save or be stunned."},
+		{Name: "Ki-Empowered Strikes", Level: 6, Description: "Unarmed strikes count as magical."},
+		{Name: "Evasion", Level: 7, Description: "DEX saves for half damage deal no damage on a success and half on a failure."},
+		{Name: "Stillness of Mind", Level: 7, Description: "Action: end one effect causing you to be charmed or frightened."},
+		{Name: "Purity of Body", Level: 10, Description: "Immune to disease and poison."},
+		{Name: "Tongue of the Sun and Moon", Level: 13, Description: "Understand all spoken languages and be understood by any creature that speaks one."},
+		{Name: "Diamond Soul", Level: 14, Description: "Proficiency in all saving throws; spend 1 ki point to reroll a failed save.",
+			SavingThrows: AbilityNames},
+		{Name: "Timeless Body", Level: 15, Description: "No longer need food or water and suffer no frailty of old age."},
+		{Name: "Empty Body", Level: 18, Description: "Spend ki to become invisible or cast astral projection."},
+		{Name: "Perfect Self", Level: 20, Description: "Regain 4 ki points when you roll initiative with none left."},
+	},
+	"paladin": {
+		{Name: "Divine Sense", Level: 1, Description: "Detect celestials, fiends and undead within 60 feet, 1 + CHA modifier times per long rest.",
+			Resource: &Resource{Ability: "CHA", Bonus: 1, Recovery: RecoveryLongRest}},
+		{Name: "Lay on Hands", Level: 1, Description: "A pool of healing power that restores hit points or cures disease and poison; refills on a long rest.",
+			Progression: scaling(1, 1, func(level int) string { return fmt.Sprintf("%d hit point pool", 5*level) }),
+			Resource:    &Resource{PerLevel: 5, Recovery: RecoveryLongRest}},
+		{Name: "Fighting Style", Level: 2, Description: "Adopt a particular style of fighting as your specialty."},
+		{Name: "Divine Smite", Level: 2, Description: "Expend a spell slot on a melee hit to deal 2d8 extra radiant damage, +1d8 per slot level above 1st."},
+		{Name: "Divine Health", Level: 3, Description: "Immune to disease."},
+		{Name: "Channel Divinity", Level: 3, Description: "Channel divine energy for an oath effect once per short or long rest.",
+			Resource: &Resource{Uses: map[int]int{3: 1}, Recovery: RecoveryShortRest}},
+		{Name: "Extra Attack", Level: 5, Description: "Attack twice when you take the Attack action."},
+		{Name: "Aura of Protection", Level: 6, Description: "You and friendly creatures nearby add your CHA modifier to saving throws.",
+			Progression: map[int]string{6: "10 ft", 18: "30 ft"}},
+		{Name: "Aura of Courage", Level: 10, Description: "You and friendly creatures nearby cannot be frightened."},
+		{Name: "Improved Divine Smite", Level: 11, Description: "Melee weapon hits deal an extra 1d8 radiant damage."},
+		{Name: "Cleansing Touch", Level: 14, Description: "End one spell on yourself or a willing creature, CHA modifier times per long rest.",
+			Resource: &Resource{Ability: "CHA", Recovery: RecoveryLongRest}},
+	},
+	"ranger": {
+		{Name: "Favored Enemy", Level: 1, Description: "Advantage on survival checks to track and INT checks to recall information about chosen enemies."},
+		{Name: "Natural Explorer", Level: 1, Description: "Expert travel and tracking in a favored terrain."},
+		{Name: "Fighting Style", Level: 2, Description: "Adopt a particular style of fighting as your specialty."},
+		{Name: "Primeval Awareness", Level: 3, Description: "Expend a spell slot to sense certain creature types nearby."},
+		{Name: "Extra Attack", Level: 5, Description: "Attack twice when you take the Attack action."},
+		{Name: "Land's Stride", Level: 8, Description: "Nonmagical difficult terrain costs no extra movement."},
+		{Name: "Hide in Plain Sight", Level: 10, Description: "Camouflage yourself for +10 to Stealth while you remain still."},
+		{Name: "Vanish", Level: 14, Description: "Hide as a bonus action and cannot be tracked by nonmagical means."},
+		{Name: "Feral Senses", Level: 18, Description: "Attacks against unseen creatures are not at disadvantage; aware of invisible creatures within 30 feet."},
+		{Name: "Foe Slayer", Level: 20, Description: "Once per turn add WIS modifier to an attack or damage roll against a favored enemy."},
+	},
+	"rogue": {
+		{Name: "Expertise", Level: 1, Description: "Double proficiency bonus for two chosen skill proficiencies or thieves' tools; two more at 6th level.",
+			Expertise: map[int]int{1: 2, 6: 4}},
+		{Name: "Sneak Attack", Level: 1, Description: "Once per turn deal extra damage with a finesse or ranged weapon when you have advantage or an ally is adjacent to the target.",
+			Progression: scaling(1, 2, func(level int) string { return fmt.Sprintf("%dd6", (level+1)/2) })},
+		{Name: "Thieves' Cant", Level: 1, Description: "You know the secret mix of dialect, jargon and code of thieves."},
+		{Name: "Cunning Action", Level: 2, Description: "Dash, Disengage or Hide as a bonus action."},
+		{Name: "Uncanny Dodge", Level: 5, Description: "Reaction: halve the damage of an attack from an attacker you can see."},
+		{Name: "Evasion", Level: 7, Description: "DEX saves for half damage deal no damage on a success and half on a failure."},
+		{Name: "Reliable Talent", Level: 11, Description: "Treat a d20 roll of 9 or lower as a 10 on proficient ability checks."},
+		{Name: "Blindsense", Level: 14, Description: "Aware of hidden or invisible creatures within 10 feet if you can hear."},
+		{Name: "Slippery Mind", Level: 15, Description: "Proficiency in WIS saving throws.",
+			SavingThrows: []string{"WIS"}},
+		{Name: "Elusive", Level: 18, Description: "No attack roll has advantage against you while you are not incapacitated."},
+		{Name: "Stroke of Luck", Level: 20, Description: "Turn a miss into a hit or a failed check into a 20 once per short or long rest.",
+			Resource: &Resource{Uses: map[int]int{20: 1}, Recovery: RecoveryShortRest}},
+	},
+	"sorcerer": {
+		{Name: "Font of Magic", Level: 2, Description: "Sorcery points convert to spell slots and back; recovers on a long rest.",
+			Progression: scaling(2, 1, func(level int) string { return fmt.Sprintf("%d sorcery points", level) }),
+			Resource:    &Resource{Name: "Sorcery Points", PerLevel: 1, Recovery: RecoveryLongRest}},
+		{Name: "Metamagic", Level: 3, Description: "Twist spells with sorcery points.",
+			Progression: map[int]string{3: "2 options", 10: "3 options", 17: "4 options"}},
+		{Name: "Sorcerous Restoration", Level: 20, Description: "Regain 4 sorcery points on a short rest."},
+	},
+	"warlock": {
+		{Name: "Eldritch Invocations", Level: 2, Description: "Fragments of forbidden knowledge granting lasting magical abilities.",
+			Progression: map[int]string{2: "2 invocations", 5: "3 invocations", 7: "4 invocations", 9: "5 invocations", 12: "6 invocations", 15: "7 invocations", 18: "8 invocations"}},
+		{Name: "Pact Boon", Level: 3, Description: "Your patron grants a Pact of the Chain, Blade or Tome."},
+		{Name: "Mystic Arcanum", Level: 11, Description: "Cast one high level spell of each arcanum level once per long rest without a slot.",
+			Progression: map[int]string{11: "6th level", 13: "6th and 7th level", 15: "6th to 8th level", 17: "6th to 9th level"}},
+		{Name: "Eldritch Master", Level: 20, Description: "Spend 1 minute entreating your patron to regain all pact slots once per long rest.",
+			Resource: &Resource{Uses: map[int]int{20: 1}, Recovery: RecoveryLongRest}},
+	},
+	"wizard": {
+		{Name: "Arcane Recovery", Level: 1, Description: "Once per day during a short rest, recover expended spell slots up to a combined level limit.",
+			Progression: scaling(1, 1, func(level int) string { return fmt.Sprintf("%d slot levels", (level+1)/2) }),
+			Resource:    &Resource{Uses: map[int]int{1: 1}, Recovery: RecoveryDawn}},
+		{Name: "Spell Mastery", Level: 18, Description: "Cast a chosen 1st and 2nd level spell at their lowest level without expending a slot."},
+		{Name: "Signature Spells", Level: 20, Description: "Two 3rd level spells are always prepared and each can be cast once per short rest without a slot."},
+	},
+}
+
+var subclassFeatures = map[string][]Feature{
+	"path of the berserker": {
+		{Name: "Frenzy", Level: 3, Description: "While raging, make a bonus melee attack each turn; gain a level of exhaustion when the rage ends."},
+		{Name: "Mindless Rage", Level: 6, Description: "Cannot be charmed or frightened while raging."},
+		{Name: "Intimidating Presence", Level: 10, Description: "Action: frighten a creature within 30 feet that fails a WIS save."},
+		{Name: "Retaliation", Level: 14, Description: "Reaction: make a melee attack against a creature within 5 feet that damages you."},
+	},
+	"college of lore": {
+		{Name: "Bonus Proficiencies", Level: 3, Description: "Gain proficiency with three skills of your choice."},
+		{Name: "Cutting Words", Level: 3, Description: "Reaction: spend Bardic Inspiration to subtract the die from an enemy's attack, check or damage roll."},
+		{Name: "Additional Magical Secrets", Level: 6, Description: "Learn two spells from any class list."},
+		{Name: "Peerless Skill", Level: 14, Description: "Spend Bardic Inspiration to add the die to your own ability check."},
+	},
+	"life domain": {
+		{Name: "Bonus Proficiency", Level: 1, Description: "Proficiency with heavy armor.", Armor: []string{ArmorHeavy}},
+		{Name: "Disciple of Life", Level: 1, Description: "Healing spells of 1st level or higher restore an additional 2 + spell level hit points."},
+		{Name: "Channel Divinity: Preserve Life", Level: 2, Description: "Distribute hit points equal to five times your cleric level among nearby creatures."},
+		{Name: "Blessed Healer", Level: 6, Description: "Healing others with a spell heals you for 2 + spell level."},
+		{Name: "Divine Strike", Level: 8, Description: "Once per turn deal extra radiant damage with a weapon attack.",
+			Progression: map[int]string{8: "1d8", 14: "2d8"}},
+		{Name: "Supreme Healing", Level: 17, Description: "Healing spells use the maximum value of their dice."},
+	},
+	"circle of the land": {
+		{Name: "Bonus Cantrip", Level: 2, Description: "Learn one additional druid cantrip."},
+		{Name: "Natural Recovery", Level: 2, Description: "Once per day during a short rest, recover spell slots up to half your druid level.",
+			Resource: &Resource{Uses: map[int]int{2: 1}, Recovery: RecoveryDawn}},
+		{Name: "Circle Spells", Level: 3, Description: "Gain always-prepared spells tied to your chosen land."},
+		{Name: "Land's Stride", Level: 6, Description: "Nonmagical difficult terrain costs no extra movement."},
+		{Name: "Nature's Ward", Level: 10, Description: "Immune to poison and disease; cannot be charmed or frightened by elementals or fey."},
+		{Name: "Nature's Sanctuary", Level: 14, Description: "Beasts and plants must succeed on a WIS save to attack you."},
+	},
+	"champion": {
+		{Name: "Improved Critical", Level: 3, Description: "Weapon attacks score a critical hit on a roll of 19 or 20."},
+		{Name: "Remarkable Athlete", Level: 7, Description: "Add half proficiency bonus to STR, DEX and CON checks you are not proficient in."},
+		{Name: "Additional Fighting Style", Level: 10, Description: "Choose a second Fighting Style."},
+		{Name: "Superior Critical", Level: 15, Description: "Weapon attacks score a critical hit on a roll of 18 to 20."},
+		{Name: "Survivor", Level: 18, Description: "Regain 5 + CON modifier hit points each turn while below half HP."},
+	},
+	"way of the open hand": {
+		{Name: "Open Hand Technique", Level: 3, Description: "Flurry of Blows hits can knock prone, push or deny reactions."},
+		{Name: "Wholeness of Body", Level: 6, Description: "Action: regain hit points equal to three times your monk level once per long rest."},
+		{Name: "Tranquility", Level: 11, Description: "Gain the effect of a sanctuary spell after each long rest."},
+		{Name: "Quivering Palm", Level: 17, Description: "Spend 3 ki points to set lethal vibrations in a creature."},
+	},
+	"oath of devotion": {
+		{Name: "Sacred Weapon", Level: 3, Description: "Channel Divinity: add CHA modifier to attack rolls with a weapon for 1 minute."},
+		{Name: "Turn the Unholy", Level: 3, Description: "Channel Divinity: turn fiends and undead."},
+		{Name: "Aura of Devotion", Level: 7, Description: "You and friendly creatures nearby cannot be charmed."},
+		{Name: "Purity of Spirit", Level: 15, Description: "Always under the effect of protection from evil and good."},
+		{Name: "Holy Nimbus", Level: 20, Description: "Emanate bright light that damages enemies once per long rest."},
+	},
+	"hunter": {
+		{Name: "Hunter's Prey", Level: 3, Description: "Colossus Slayer, Giant Killer or Horde Breaker."},
+		{Name: "Defensive Tactics", Level: 7, Description: "Escape the Horde, Multiattack Defense or Steel Will."},
+		{Name: "Multiattack", Level: 11, Description: "Volley or Whirlwind Attack."},
+		{Name: "Superior Hunter's Defense", Level: 15, Description: "Evasion, Stand Against the Tide or Uncanny Dodge."},
+	},
+	"thief": {
+		{Name: "Fast Hands", Level: 3, Description: "Cunning Action can make Sleight of Hand checks, use thieves' tools or Use an Object."},
+		{Name: "Second-Story Work", Level: 3, Description: "Climbing costs no extra movement; running jumps extend by DEX modifier feet."},
+		{Name: "Supreme Sneak", Level: 9, Description: "Advantage on Stealth checks when moving no more than half speed."},
+		{Name: "Use Magic Device", Level: 13, Description: "Ignore class, race and level requirements on magic items."},
+		{Name: "Thief's Reflexes", Level: 17, Description: "Take two turns during the first round of combat."},
+	},
+	"draconic bloodline": {
+		{Name: "Dragon Ancestor", Level: 1, Description: "Choose a dragon ancestor; speak Draconic and double proficiency on CHA checks with dragons."},
+		{Name: "Draconic Resilience", Level: 1, Description: "Hit point maximum increases by 1 per sorcerer level; without armor, AC equals 13 + DEX modifier.",
+			UnarmoredDefense: &UnarmoredDefense{Base: 13, AllowsShield: true}, HPPerLevel: 1},
+		{Name: "Elemental Affinity", Level: 6, Description: "Add CHA modifier to damage of your ancestry's element; spend 1 sorcery point for resistance."},
+		{Name: "Dragon Wings", Level: 14, Description: "Sprout wings granting a flying speed equal to your speed."},
+		{Name: "Draconic Presence", Level: 18, Description: "Spend 5 sorcery points to exude an aura of awe or fear."},
+	},
+	"the fiend": {
+		{Name: "Dark One's Blessing", Level: 1, Description: "Reducing a hostile creature to 0 HP grants temporary HP equal to CHA modifier + warlock level."},
+		{Name: "Dark One's Own Luck", Level: 6, Description: "Add a d10 to an ability check or saving throw once per short or long rest."},
+		{Name: "Fiendish Resilience", Level: 10, Description: "Choose a damage type after each rest to gain resistance to it."},
+		{Name: "Hurl Through Hell", Level: 14, Description: "Send a creature you hit through the lower planes for 10d10 psychic damage."},
+	},
+	"school of evocation": {
+		{Name: "Evocation Savant", Level: 2, Description: "Copying evocation spells takes half the gold and time."},
+		{Name: "Sculpt Spells", Level: 2, Description: "Protect chosen creatures from your evocation spells."},
+		{Name: "Potent Cantrip", Level: 6, Description: "Creatures that succeed on a save against your cantrips still take half damage."},
+		{Name: "Empowered Evocation", Level: 10, Description: "Add INT modifier to one damage roll of wizard evocation spells."},
+		{Name: "Overchannel", Level: 14, Description: "Deal maximum damage with a spell of 5th level or lower."},
+	},
+}
+
+func (c *Character) Features() []CharacterFeature {
+	var features []CharacterFeature
+	for _, cl := range c.ClassLevels() {
+		for _, f := range classFeatures[strings.ToLower(string(cl.Class))] {
+			if f.Level <= cl.Level {
+				features = append(features, CharacterFeature{Feature: f, Class: cl.Class, Source: string(cl.Class), ClassLevel: cl.Level})
+			}
+		}
+		if cl.Subclass == "" {
+			continue
+		}
+		for _, f := range subclassFeatures[strings.ToLower(cl.Subclass)] {
+			if f.Level <= cl.Level {
+				features = append(features, CharacterFeature{Feature: f, Class: cl.Class, Source: cl.Subclass, ClassLevel: cl.Level})
+			}
+		}
+	}
+	return features
+}
+
+func (c *Character) HasFeature(name string) bool {
+	for _, f := range c.Features() {
+		if strings.EqualFold(f.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Character) unarmoredArmorClass() int {
+	dexMod := Modifier(c.AbilityScores.Dex)
+	best := 10 + dexMod
+	for _, f := range c.Features() {
+		ud := f.UnarmoredDefense
+		if ud == nil || (c.Equipment.Shield != nil && !ud.AllowsShield) {
+			continue
+		}
+		ac := ud.Base + dexMod
+		if ud.Ability != "" {
+			ac += Modifier(c.AbilityScores.Get(ud.Ability))
+		}
+		best = max(best, ac)
+	}
+	return best
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var classHitDice = map[string]int{
+	"barbarian": 12,
+	"fighter":   10,
+	"paladin":   10,
+	"ranger":    10,
+	"bard":      8,
+	"cleric":    8,
+	"druid":     8,
+	"monk":      8,
+	"rogue":     8,
+	"warlock":   8,
+	"sorcerer":  6,
+	"wizard":    6,
+}
+
+func HitDie(class Class) int {
+	if die, ok := classHitDice[strings.ToLower(string(class))]; ok {
+		return die
+	}
+	return 8
+}
+
+func AverageHitDieGain(die int) int {
+	return die/2 + 1
+}
+
+func (c *Character) CalculateMaxHP() int {
+	dice := c.levelHitDice()
+	if len(dice) == 0 {
+		return 0
+	}
+	conMod := Modifier(c.AbilityScores.Con)
+
+	total := max(dice[0]+conMod, 1)
+	for i, die := range dice[1:] {
+		gain := AverageHitDieGain(die)
+		if i < len(c.HitPointRolls) {
+			gain = c.HitPointRolls[i]
+		}
+		total += max(gain+conMod, 1)
+	}
+	for _, f := range c.Features() {
+		total += f.HPPerLevel * f.ClassLevel
+	}
+	if race, ok := GetRace(c.Race); ok {
+		total += race.HPPerLevel * len(dice)
+	}
+	for _, feat := range c.featData() {
+		total += feat.HPPerLevel * len(dice)
+	}
+	return total
+}
+
+func (c *Character) levelHitDice() []int {
+	var dice []int
+	for _, cl := range c.ClassLevels() {
+		for i := 0; i < cl.Level; i++ {
+			dice = append(dice, HitDie(cl.Class))
+		}
+	}
+	return dice
+}
+
+func (c *Character) updateHitPoints() {
+	maxHP := c.hitPointMaximum()
+	if c.MaxHP == 0 {
+		c.CurrentHP = maxHP
+	} else if maxHP != c.MaxHP {
+		c.CurrentHP += maxHP - c.MaxHP
+	}
+	c.MaxHP = maxHP
+	c.CurrentHP = min(max(c.CurrentHP, 0), c.MaxHP)
+}
+
+func (c *Character) HitDice() map[int]int {
+	dice := map[int]int{}
+	for _, cl := range c.ClassLevels() {
+		dice[HitDie(cl.Class)] += cl.Level
+	}
+	return dice
+}
+
+func (c *Character) HitDiceRemaining() map[int]int {
+	remaining := map[int]int{}
+	for die, total := range c.HitDice() {
+		remaining[die] = max(total-c.HitDiceSpent[die], 0)
+	}
+	return remaining
+}
+
+func FormatHitDice(dice map[int]int) string {
+	sizes := make([]int, 0, len(dice))
+	for die := range dice {
+		sizes = append(sizes, die)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(sizes)))
+
+	parts := make([]string, 0, len(sizes))
+	for _, die := range sizes {
+		parts = append(parts, fmt.Sprintf("%dd%d", dice[die], die))
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, " + ")
+}
+
+func (c *Character) TakeDamage(amount int) (int, error) {
+	if amount < 0 {
+		return 0, fmt.Errorf("damage cannot be negative")
+	}
+	absorbed := min(amount, c.TempHP)
+	c.TempHP -= absorbed
+	c.CurrentHP = max(c.CurrentHP-(amount-absorbed), 0)
+	return absorbed, nil
+}
+
+func (c *Character) Heal(amount int) error {
+	if amount < 0 {
+		return fmt.Errorf("healing cannot be negative")
+	}
+	c.CurrentHP = min(c.CurrentHP+amount, c.MaxHP)
+	return nil
+}
+
+func (c *Character) GrantTempHP(amount int) error {
+	if amount < 0 {
+		return fmt.Errorf("temporary hit points cannot be negative")
+	}
+	if amount > c.TempHP {
+		c.TempHP = amount
+	}
+	return nil
+}
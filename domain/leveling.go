@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+const (
+	MaxLevel        = 20
+	MaxAbilityScore = 20
+)
+
+const (
+	HPMethodAverage = "average"
+	HPMethodRoll    = "roll"
+)
+
+var asiLevels = []int{4, 8, 12, 16, 19}
+
+var classASILevels = map[string][]int{
+	"fighter": {6, 14},
+	"rogue":   {10},
+}
+
+type LevelUpOptions struct {
+	Class       Class
+	HPMethod    string
+	Seed        int64
+	ASI         []string
+	Feat        string
+	FeatAbility string
+}
+
+type LevelUpSummary struct {
+	Class               Class
+	ClassLevel          int
+	OldLevel            int
+	NewLevel            int
+	HPGained            int
+	HPRoll              int
+	HPMethod            string
+	OldProficiencyBonus int
+	NewProficiencyBonus int
+	AbilityIncreases    map[string]int
+	Feat                *CharacterFeat
+	OldSpellSlots       map[int]int
+	NewSpellSlots       map[int]int
+}
+
+func IsASILevel(class Class, level int) bool {
+	for _, l := range asiLevels {
+		if l == level {
+			return true
+		}
+	}
+	for _, l := range classASILevels[strings.ToLower(string(class))] {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Character) LevelUp(opts LevelUpOptions) (*LevelUpSummary, error) {
+	c.MigrateClasses()
+	if c.Level >= MaxLevel {
+		return nil, fmt.Errorf("character is already at maximum level %d", MaxLevel)
+	}
+
+	class := Class(strings.ToLower(string(opts.Class)))
+	if class == "" {
+		class = c.Class
+	}
+	if err := c.CanMulticlassInto(class); err != nil {
+		return nil, err
+	}
+	classLevel := c.ClassLevel(class) + 1
+
+	var feat *CharacterFeat
+	var increases map[string]int
+	if opts.Feat != "" {
+		if !IsASILevel(class, classLevel) {
+			return nil, fmt.Errorf("%s level %d does not grant an ability score increase or feat", class, classLevel)
+		}
+		if len(opts.ASI) > 0 {
+			return nil, fmt.Errorf("choose either an ability score increase or a feat, not both")
+		}
+		_, choice, err := c.featChoice(opts.Feat, opts.FeatAbility)
+		if err != nil {
+			return nil, err
+		}
+		feat = &choice
+	} else {
+		var err error
+		increases, err = c.parseAbilityIncreases(class, classLevel, opts.ASI)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	summary := &LevelUpSummary{
+		Class:               class,
+		ClassLevel:          classLevel,
+		OldLevel:            c.Level,
+		NewLevel:            c.Level + 1,
+		OldProficiencyBonus: c.ProficiencyBonus,
+		OldSpellSlots:       c.SpellSlots,
+		AbilityIncreases:    increases,
+		Feat:                feat,
+	}
+
+	die := HitDie(class)
+	gain := AverageHitDieGain(die)
+	summary.HPMethod = HPMethodAverage
+	if strings.ToLower(opts.HPMethod) == HPMethodRoll {
+		gain = rand.New(rand.NewSource(opts.Seed)).Intn(die) + 1
+		summary.HPMethod = HPMethodRoll
+		summary.HPRoll = gain
+	} else if opts.HPMethod != "" && strings.ToLower(opts.HPMethod) != HPMethodAverage {
+		return nil, fmt.Errorf("unknown hit point method: %s", opts.HPMethod)
+	}
+
+	dice := c.levelHitDice()
+	for len(c.HitPointRolls) < len(dice)-1 {
+		c.HitPointRolls = append(c.HitPointRolls, AverageHitDieGain(dice[len(c.HitPointRolls)+1]))
+	}
+	c.HitPointRolls = append(c.HitPointRolls, gain)
+
+	for ability, inc := range increases {
+		c.AbilityScores.add(ability, inc)
+	}
+	if feat != nil {
+		c.addFeat(*feat)
+	}
+
+	oldMaxHP := c.MaxHP
+	c.addClassLevel(class)
+	c.UpdateStats()
+	c.SpellSwapAvailable = KnowsSpells(class) && classLevel > 1
+
+	summary.HPGained = c.MaxHP - oldMaxHP
+	summary.NewProficiencyBonus = c.ProficiencyBonus
+	summary.NewSpellSlots = c.SpellSlots
+	return summary, nil
+}
+
+func (c *Character) parseAbilityIncreases(class Class, level int, choices []string) (map[string]int, error) {
+	required := IsASILevel(class, level)
+	if !required {
+		if len(choices) > 0 {
+			return nil, fmt.Errorf("%s level %d does not grant an ability score increase", class, level)
+		}
+		return nil, nil
+	}
+
+	increases := map[string]int{}
+	switch len(choices) {
+	case 1:
+		increases[strings.ToUpper(choices[0])] = 2
+	case 2:
+		if strings.EqualFold(choices[0], choices[1]) {
+			increases[strings.ToUpper(choices[0])] = 2
+		} else {
+			increases[strings.ToUpper(choices[0])] = 1
+			increases[strings.ToUpper(choices[1])] = 1
+		}
+	default:
+		return nil, fmt.Errorf("%s level %d grants an ability score increase: choose one ability for +2, two abilities for +1 or a feat", class, level)
+	}
+
+	for ability, inc := range increases {
+		if !IsAbility(ability) {
+			return nil, fmt.Errorf("unknown ability: %s", ability)
+		}
+		if c.AbilityScores.Get(ability)+inc > MaxAbilityScore {
+			return nil, fmt.Errorf("%s cannot be increased above %d", ability, MaxAbilityScore)
+		}
+	}
+	return increases, nil
+}
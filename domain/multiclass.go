@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ClassLevel struct {
+	Class    Class
+	Subclass string `json:"subclass,omitempty"`
+	Level    int
+}
+
+const MulticlassMinimumScore = 13
+
+var multiclassPrerequisites = map[string][][]string{
+	"barbarian": {{"STR"}},
+	"bard":      {{"CHA"}},
+	"cleric":    {{"WIS"}},
+	"druid":     {{"WIS"}},
+	"fighter":   {{"STR", "DEX"}},
+	"monk":      {{"DEX"}, {"WIS"}},
+	"paladin":   {{"STR"}, {"CHA"}},
+	"ranger":    {{"DEX"}, {"WIS"}},
+	"rogue":     {{"DEX"}},
+	"sorcerer":  {{"CHA"}},
+	"warlock":   {{"CHA"}},
+	"wizard":    {{"INT"}},
+}
+
+func MeetsMulticlassPrerequisites(class Class, scores AbilityScores) error {
+	requirements, ok := multiclassPrerequisites[strings.ToLower(string(class))]
+	if !ok {
+		return fmt.Errorf("unknown class: %s", class)
+	}
+	for _, anyOf := range requirements {
+		met := false
+		for _, ability := range anyOf {
+			if scores.Get(ability) >= MulticlassMinimumScore {
+				met = true
+				break
+			}
+		}
+		if !met {
+			return fmt.Errorf("%s requires %s %d or higher to multiclass",
+				class, strings.Join(anyOf, " or "), MulticlassMinimumScore)
+		}
+	}
+	return nil
+}
+
+func (c *Character) ClassLevels() []ClassLevel {
+	if len(c.Classes) > 0 {
+		return c.Classes
+	}
+	if c.Class == "" || c.Level < 1 {
+		return nil
+	}
+	return []ClassLevel{{Class: c.Class, Level: c.Level}}
+}
+
+func (c *Character) MigrateClasses() {
+	if len(c.Classes) == 0 {
+		c.Classes = c.ClassLevels()
+	}
+	if len(c.Classes) == 0 {
+		return
+	}
+	total := 0
+	for _, cl := range c.Classes {
+		total += cl.Level
+	}
+	c.Class = c.Classes[0].Class
+	c.Level = total
+}
+
+func (c *Character) ClassLevel(class Class) int {
+	for _, cl := range c.ClassLevels() {
+		if strings.EqualFold(string(cl.Class), string(class)) {
+			return cl.Level
+		}
+	}
+	return 0
+}
+
+func (c *Character) HasClass(class Class) bool {
+	return c.ClassLevel(class) > 0
+}
+
+func (c *Character) IsMulticlass() bool {
+	return len(c.ClassLevels()) > 1
+}
+
+func (c *Character) ClassSummary() string {
+	levels := c.ClassLevels()
+	if len(levels) == 0 {
+		return string(c.Class)
+	}
+	parts := make([]string, 0, len(levels))
+	for _, cl := range levels {
+		part := string(cl.Class)
+		if len(levels) > 1 {
+			part = fmt.Sprintf("%s %d", cl.Class, cl.Level)
+		}
+		if cl.Subclass != "" {
+			part += fmt.Sprintf(" (%s)", cl.Subclass)
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " / ")
+}
+
+func (c *Character) CanMulticlassInto(class Class) error {
+	if err := ValidateClass(class); err != nil {
+		return err
+	}
+	if c.HasClass(class) || len(c.ClassLevels()) == 0 {
+		return nil
+	}
+	for _, cl := range c.ClassLevels() {
+		if err := MeetsMulticlassPrerequisites(cl.Class, c.AbilityScores); err != nil {
+			return err
+		}
+	}
+	return MeetsMulticlassPrerequisites(class, c.AbilityScores)
+}
+
+func (c *Character) addClassLevel(class Class) {
+	for i := range c.Classes {
+		if strings.EqualFold(string(c.Classes[i].Class), string(class)) {
+			c.Classes[i].Level++
+			return
+		}
+	}
+	c.Classes = append(c.Classes, ClassLevel{Class: class, Level: 1})
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+func PreparationLimit(class Class, level, abilityMod int) int {
+	if !PreparesSpells(string(class)) {
+		return 0
+	}
+	if strings.EqualFold(string(class), "paladin") {
+		level /= 2
+	}
+	return max(abilityMod+level, 1)
+}
+
+func (c *Character) MaxPreparedSpells(class Class) int {
+	mod := Modifier(c.AbilityScores.Get(SpellcastingAbility(class)))
+	return PreparationLimit(class, c.ClassLevel(class), mod)
+}
+
+func (c *Character) preparingClass(spell Spell) (ClassLevel, error) {
+	var preparers []ClassLevel
+	for _, cl := range c.ClassLevels() {
+		if PreparesSpells(string(cl.Class)) {
+			preparers = append(preparers, cl)
+		}
+	}
+	if len(preparers) == 0 {
+		return ClassLevel{}, fmt.Errorf("this class cannot prepare spells")
+	}
+	if len(spell.Class) == 0 {
+		return preparers[0], nil
+	}
+	for _, cl := range preparers {
+		if containsFold(spell.Class, string(cl.Class)) {
+			return cl, nil
+		}
+	}
+	return ClassLevel{}, fmt.Errorf("%s is not on the spell list of a class that prepares spells", spell.Name)
+}
+
+func (c *Character) preparedSpellCount(class Class) int {
+	count := 0
+	for _, s := range c.PreparedSpells {
+		if cl, err := c.preparingClass(s); err == nil && strings.EqualFold(string(cl.Class), string(class)) {
+			count++
+		}
+	}
+	return count
+}
+
+func (c *Character) IsPrepared(name string) bool {
+	for _, s := range c.PreparedSpells {
+		if s.HasName(strings.TrimSpace(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Character) PrepareSpell(spell Spell) error {
+	cl, err := c.preparingClass(spell)
+	if err != nil {
+		return err
+	}
+	if spell.Level == 0 {
+		return fmt.Errorf("cantrips are always ready to cast, learn %s instead", spell.Name)
+	}
+	if spell.Level > MaxSpellLevel(cl.Class, cl.Level) {
+		return fmt.Errorf("the spell has higher level than the available spell slots")
+	}
+	if c.IsPrepared(spell.Name) {
+		return fmt.Errorf("spell already prepared: %s", spell.Name)
+	}
+	if strings.EqualFold(string(cl.Class), "wizard") {
+		if _, ok := c.InSpellbook(spell.Name); !ok {
+			return fmt.Errorf("%s is not in %s's spellbook", spell.Name, c.Name)
+		}
+	}
+	if limit := c.MaxPreparedSpells(cl.Class); c.preparedSpellCount(cl.Class) >= limit {
+		return fmt.Errorf("%s %d can prepare at most %d spells, unprepare one first", cl.Class, cl.Level, limit)
+	}
+
+	c.PreparedSpells = append(c.PreparedSpells, spell)
+	c.UpdateStats()
+	return nil
+}
+
+func (c *Character) UnprepareSpell(name string) (Spell, error) {
+	for i, s := range c.PreparedSpells {
+		if s.HasName(strings.TrimSpace(name)) {
+			c.PreparedSpells = append(c.PreparedSpells[:i], c.PreparedSpells[i+1:]...)
+			return s, nil
+		}
+	}
+	return Spell{}, fmt.Errorf("%s has not prepared %s", c.Name, name)
+}
+
+func (c *Character) migratePreparedSpells() {
+	var kept []Spell
+	for _, s := range c.Spells {
+		cl, err := c.spellClass(s)
+		if s.Level == 0 || err != nil || !PreparesSpells(string(cl.Class)) {
+			kept = append(kept, s)
+			continue
+		}
+		if !c.IsPrepared(s.Name) {
+			c.PreparedSpells = append(c.PreparedSpells, s)
+		}
+		if c.usesSpellbook(s) {
+			kept = append(kept, s)
+		}
+	}
+	c.Spells = kept
+}
+
+func (c *Character) castableSpell(name string) (Spell, bool) {
+	for _, s := range c.PreparedSpells {
+		if s.HasName(strings.TrimSpace(name)) {
+			return s, true
+		}
+	}
+	spell, ok := c.findSpell(name)
+	if !ok {
+		return Spell{}, false
+	}
+	if spell.Level == 0 {
+		return spell, true
+	}
+	cl, err := c.spellClass(spell)
+	return spell, err == nil && !PreparesSpells(string(cl.Class))
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ProficiencyLevel int
+
+const (
+	ProficiencyNone ProficiencyLevel = iota
+	ProficiencyHalf
+	ProficiencyProficient
+	ProficiencyExpertise
+)
+
+func (p ProficiencyLevel) String() string {
+	switch p {
+	case ProficiencyHalf:
+		return "half"
+	case ProficiencyProficient:
+		return "proficient"
+	case ProficiencyExpertise:
+		return "expertise"
+	default:
+		return "none"
+	}
+}
+
+func (p ProficiencyLevel) Bonus(proficiencyBonus int) int {
+	switch p {
+	case ProficiencyHalf:
+		return proficiencyBonus / 2
+	case ProficiencyProficient:
+		return proficiencyBonus
+	case ProficiencyExpertise:
+		return proficiencyBonus * 2
+	default:
+		return 0
+	}
+}
+
+func (c *Character) SkillProficiency(skill string) ProficiencyLevel {
+	repo := NewSkillRepository()
+	switch {
+	case repo.HasSkill(c.SkillExpertise, skill) && repo.HasSkill(c.SkillProficiencies, skill):
+		return ProficiencyExpertise
+	case repo.HasSkill(c.SkillProficiencies, skill):
+		return ProficiencyProficient
+	case c.hasHalfProficiency():
+		return ProficiencyHalf
+	default:
+		return ProficiencyNone
+	}
+}
+
+func (c *Character) SkillBonus(skill Skill) int {
+	return Modifier(c.AbilityScores.Get(skill.Ability)) + c.SkillProficiency(skill.Name).Bonus(c.ProficiencyBonus)
+}
+
+const PassiveAdvantageBonus = 5
+
+var PassiveSkills = []string{SkillPerception, SkillInvestigation, SkillInsight}
+
+func (c *Character) PassiveScore(skillName string) int {
+	return c.PassiveScoreWith(skillName, false)
+}
+
+func (c *Character) PassiveScoreWith(skillName string, advantage bool) int {
+	skill, ok := NewSkillRepository().FindSkill(skillName)
+	if !ok {
+		return 10
+	}
+	score := 10 + c.SkillBonus(skill)
+	disadvantage := c.HasCheckDisadvantage()
+	if advantage && !disadvantage {
+		score += PassiveAdvantageBonus
+	}
+	if disadvantage && !advantage {
+		score -= PassiveAdvantageBonus
+	}
+	for _, feat := range c.featData() {
+		score += feat.PassiveBonus[skill.Name]
+	}
+	return score
+}
+
+func (c *Character) hasHalfProficiency() bool {
+	for _, f := range c.Features() {
+		if f.HalfProficiency {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Character) ExpertiseSlots() int {
+	total := 0
+	for _, f := range c.Features() {
+		best := 0
+		for lvl, count := range f.Expertise {
+			if lvl <= f.ClassLevel && count > best {
+				best = count
+			}
+		}
+		total += best
+	}
+	return total
+}
+
+func (c *Character) ChooseExpertise(skills []string) ([]string, error) {
+	repo := NewSkillRepository()
+	chosen, err := repo.canonicalSkills(skills)
+	if err != nil {
+		return nil, err
+	}
+	if len(chosen) == 0 {
+		return nil, fmt.Errorf("choose at least one skill")
+	}
+	available := c.ExpertiseSlots() - len(c.SkillExpertise)
+	if available <= 0 {
+		return nil, fmt.Errorf("%s has no expertise choices available", c.Name)
+	}
+	if len(chosen) > available {
+		return nil, fmt.Errorf("%s can choose %d more expertise skill(s), got %d", c.Name, available, len(chosen))
+	}
+	for _, skill := range chosen {
+		if !repo.HasSkill(c.SkillProficiencies, skill) {
+			return nil, fmt.Errorf("%s is not proficient in %s (proficient in: %s)", c.Name, skill, strings.Join(c.SkillProficiencies, ", "))
+		}
+		if repo.HasSkill(c.SkillExpertise, skill) {
+			return nil, fmt.Errorf("%s already has expertise in %s", c.Name, skill)
+		}
+	}
+	c.SkillExpertise = append(c.SkillExpertise, chosen...)
+	return chosen, nil
+}
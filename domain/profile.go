@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Profile struct {
+	PlayerName        string   `json:"player_name,omitempty"`
+	Alignment         string   `json:"alignment,omitempty"`
+	PersonalityTraits []string `json:"personality_traits,omitempty"`
+	Ideal             string   `json:"ideal,omitempty"`
+	Bond              string   `json:"bond,omitempty"`
+	Flaw              string   `json:"flaw,omitempty"`
+	Appearance        string   `json:"appearance,omitempty"`
+	Backstory         string   `json:"backstory,omitempty"`
+	TraitSeed         int64    `json:"trait_seed,omitempty"`
+}
+
+type ProfileUpdate struct {
+	PlayerName        string
+	Alignment         string
+	PersonalityTraits []string
+	Ideal             string
+	Bond              string
+	Flaw              string
+	Appearance        string
+	Backstory         string
+}
+
+var Alignments = []string{
+	"Lawful Good", "Neutral Good", "Chaotic Good",
+	"Lawful Neutral", "Neutral", "Chaotic Neutral",
+	"Lawful Evil", "Neutral Evil", "Chaotic Evil",
+	"Unaligned",
+}
+
+var alignmentAliases = map[string]string{
+	"lg": "Lawful Good", "ng": "Neutral Good", "cg": "Chaotic Good",
+	"ln": "Lawful Neutral", "n": "Neutral", "tn": "Neutral", "true neutral": "Neutral", "cn": "Chaotic Neutral",
+	"le": "Lawful Evil", "ne": "Neutral Evil", "ce": "Chaotic Evil",
+}
+
+func ParseAlignment(value string) (string, error) {
+	key := strings.ToLower(strings.Join(strings.Fields(strings.ReplaceAll(value, "-", " ")), " "))
+	if alignment, ok := alignmentAliases[key]; ok {
+		return alignment, nil
+	}
+	for _, alignment := range Alignments {
+		if strings.EqualFold(alignment, key) {
+			return alignment, nil
+		}
+	}
+	options := make([]string, 0, len(Alignments))
+	for _, alignment := range Alignments {
+		options = append(options, strings.ToLower(alignment))
+	}
+	return "", newValidationError("alignment", value, options)
+}
+
+func (c *Character) UpdateProfile(update ProfileUpdate) ([]string, error) {
+	var changed []string
+	if update.Alignment != "" {
+		alignment, err := ParseAlignment(update.Alignment)
+		if err != nil {
+			return nil, err
+		}
+		c.Profile.Alignment = alignment
+		changed = append(changed, "alignment")
+	}
+	set := func(field *string, value, label string) {
+		if value = strings.TrimSpace(value); value != "" {
+			*field = value
+			changed = append(changed, label)
+		}
+	}
+	set(&c.Profile.PlayerName, update.PlayerName, "player name")
+	if len(update.PersonalityTraits) > 0 {
+		c.Profile.PersonalityTraits = update.PersonalityTraits
+		changed = append(changed, "personality traits")
+	}
+	set(&c.Profile.Ideal, update.Ideal, "ideal")
+	set(&c.Profile.Bond, update.Bond, "bond")
+	set(&c.Profile.Flaw, update.Flaw, "flaw")
+	set(&c.Profile.Appearance, update.Appearance, "appearance")
+	set(&c.Profile.Backstory, update.Backstory, "backstory")
+	if len(changed) == 0 {
+		return nil, fmt.Errorf("no profile fields to update")
+	}
+	return changed, nil
+}
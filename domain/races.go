@@ -0,0 +1,448 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	SizeSmall  = "Small"
+	SizeMedium = "Medium"
+)
+
+type Trait struct {
+	Name        string
+	Description string
+}
+
+type RaceData struct {
+	Name                string
+	Parent              string
+	ASI                 map[string]int
+	ASIChoices          int
+	ASIChoiceExclude    []string
+	Size                string
+	Speed               int
+	Darkvision          int
+	Languages           []string
+	LanguageChoices     int
+	Resistances         []string
+	SkillProficiencies  []string
+	ArmorProficiencies  []string
+	WeaponProficiencies []string
+	ToolProficiencies   []string
+	HPPerLevel          int
+	Traits              []Trait
+}
+
+var raceRegistry = map[string]RaceData{
+	"human": {
+		Name: "Human", ASI: map[string]int{"Str": 1, "Dex": 1, "Con": 1, "Int": 1, "Wis": 1, "Cha": 1},
+		Size: SizeMedium, Speed: 30, Languages: []string{"Common"}, LanguageChoices: 1,
+	},
+	"variant human": {
+		Name: "Variant Human", ASI: map[string]int{}, ASIChoices: 2,
+		Size: SizeMedium, Speed: 30, Languages: []string{"Common"}, LanguageChoices: 1,
+		Traits: []Trait{
+			{"Skills", "Gain proficiency in one skill of your choice."},
+			{"Feat", "Gain one feat of your choice."},
+		},
+	},
+	"dwarf": {
+		Name: "Dwarf", ASI: map[string]int{"Con": 2},
+		Size: SizeMedium, Speed: 25, Darkvision: 60, Languages: []string{"Common", "Dwarvish"},
+		Resistances:         []string{"poison"},
+		WeaponProficiencies: []string{"battleaxe", "handaxe", "light hammer", "warhammer"},
+		ToolProficiencies:   []string{"smith's, brewer's or mason's tools (choose one)"},
+		Traits: []Trait{
+			{"Darkvision", "See in dim light within 60 feet as if it were bright light."},
+			{"Dwarven Resilience", "Advantage on saves against poison and resistance to poison damage."},
+			{"Dwarven Combat Training", "Proficiency with battleaxe, handaxe, light hammer and warhammer."},
+			{"Tool Proficiency", "Proficiency with smith's, brewer's or mason's tools."},
+			{"Stonecunning", "Double proficiency on History checks about stonework."},
+			{"Speed", "Speed is not reduced by wearing heavy armor."},
+		},
+	},
+	"hill dwarf": {
+		Name: "Hill Dwarf", Parent: "dwarf", ASI: map[string]int{"Wis": 1}, HPPerLevel: 1,
+		Traits: []Trait{{"Dwarven Toughness", "Hit point maximum increases by 1 per level."}},
+	},
+	"dwarf mountain": {
+		Name: "Mountain Dwarf", Parent: "dwarf", ASI: map[string]int{"Str": 2},
+		ArmorProficiencies: []string{ArmorLight, ArmorMedium},
+		Traits:             []Trait{{"Dwarven Armor Training", "Proficiency with light and medium armor."}},
+	},
+	"elf": {
+		Name: "Elf", ASI: map[string]int{"Dex": 2},
+		Size: SizeMedium, Speed: 30, Darkvision: 60, Languages: []string{"Common", "Elvish"},
+		SkillProficiencies: []string{SkillPerception},
+		Traits: []Trait{
+			{"Darkvision", "See in dim light within 60 feet as if it were bright light."},
+			{"Keen Senses", "Proficiency in the Perception skill."},
+			{"Fey Ancestry", "Advantage on saves against being charmed; magic cannot put you to sleep."},
+			{"Trance", "Meditate for 4 hours instead of sleeping."},
+		},
+	},
+	"elf high": {
+		Name: "High Elf", Parent: "elf", ASI: map[string]int{"Int": 1}, LanguageChoices: 1,
+		WeaponProficiencies: []string{"longsword", "shortsword", "shortbow", "longbow"},
+		Traits: []Trait{
+			{"Elf Weapon Training", "Proficiency with longsword, shortsword, shortbow and longbow."},
+			{"Cantrip", "Know one wizard cantrip, cast with INT."},
+			{"Extra Language", "Speak, read and write one extra language."},
+		},
+	},
+	"elf wood": {
+		Name: "Wood Elf", Parent: "elf", ASI: map[string]int{"Wis": 1}, Speed: 35,
+		WeaponProficiencies: []string{"longsword", "shortsword", "shortbow", "longbow"},
+		Traits: []Trait{
+			{"Elf Weapon Training", "Proficiency with longsword, shortsword, shortbow and longbow."},
+			{"Fleet of Foot", "Base walking speed is 35 feet."},
+			{"Mask of the Wild", "Attempt to hide when lightly obscured by natural phenomena."},
+		},
+	},
+	"elf drow": {
+		Name: "Drow", Parent: "elf", ASI: map[string]int{"Cha": 1}, Darkvision: 120,
+		WeaponProficiencies: []string{"rapier", "shortsword", "hand crossbow"},
+		Traits: []Trait{
+			{"Superior Darkvision", "Darkvision out to 120 feet."},
+			{"Sunlight Sensitivity", "Disadvantage on attacks and sight-based Perception in direct sunlight."},
+			{"Drow Magic", "Know dancing lights; later cast faerie fire and darkness once per long rest."},
+			{"Drow Weapon Training", "Proficiency with rapiers, shortswords and hand crossbows."},
+		},
+	},
+	"halfling": {
+		Name: "Halfling", ASI: map[string]int{"Dex": 2},
+		Size: SizeSmall, Speed: 25, Languages: []string{"Common", "Halfling"},
+		Traits: []Trait{
+			{"Lucky", "Reroll a natural 1 on an attack roll, ability check or saving throw."},
+			{"Brave", "Advantage on saves against being frightened."},
+			{"Halfling Nimbleness", "Move through the space of any creature larger than you."},
+		},
+	},
+	"lightfoot halfling": {
+		Name: "Lightfoot Halfling", Parent: "halfling", ASI: map[string]int{"Cha": 1},
+		Traits: []Trait{{"Naturally Stealthy", "Hide when obscured only by a creature at least one size larger."}},
+	},
+	"stout halfling": {
+		Name: "Stout Halfling", Parent: "halfling", ASI: map[string]int{"Con": 1},
+		Resistances: []string{"poison"},
+		Traits:      []Trait{{"Stout Resilience", "Advantage on saves against poison and resistance to poison damage."}},
+	},
+	"dragonborn": {
+		Name: "Dragonborn", ASI: map[string]int{"Str": 2, "Cha": 1},
+		Size: SizeMedium, Speed: 30, Languages: []string{"Common", "Draconic"},
+		Traits: []Trait{
+			{"Draconic Ancestry", "Choose a dragon type, which sets breath weapon and resistance damage type."},
+			{"Breath Weapon", "Exhale destructive energy once per short or long rest."},
+			{"Damage Resistance", "Resistance to the damage type of your draconic ancestry."},
+		},
+	},
+	"gnome": {
+		Name: "Gnome", ASI: map[string]int{"Int": 2},
+		Size: SizeSmall, Speed: 25, Darkvision: 60, Languages: []string{"Common", "Gnomish"},
+		Traits: []Trait{
+			{"Darkvision", "See in dim light within 60 feet as if it were bright light."},
+			{"Gnome Cunning", "Advantage on INT, WIS and CHA saves against magic."},
+		},
+	},
+	"gnome forest": {
+		Name: "Forest Gnome", Parent: "gnome", ASI: map[string]int{"Dex": 1},
+		Traits: []Trait{
+			{"Natural Illusionist", "Know the minor illusion cantrip."},
+			{"Speak with Small Beasts", "Communicate simple ideas with Small or smaller beasts."},
+		},
+	},
+	"gnome rock": {
+		Name: "Rock Gnome", Parent: "gnome", ASI: map[string]int{"Con": 1},
+		ToolProficiencies: []string{"tinker's tools"},
+		Traits: []Trait{
+			{"Artificer's Lore", "Double proficiency on History checks about magic items, alchemy and technology."},
+			{"Tinker", "Proficiency with tinker's tools; build tiny clockwork devices."},
+		},
+	},
+	"half elf": {
+		Name: "Half-Elf", ASI: map[string]int{"Cha": 2}, ASIChoices: 2, ASIChoiceExclude: []string{"CHA"},
+		Size: SizeMedium, Speed: 30, Darkvision: 60, Languages: []string{"Common", "Elvish"}, LanguageChoices: 1,
+		Traits: []Trait{
+			{"Darkvision", "See in dim light within 60 feet as if it were bright light."},
+			{"Fey Ancestry", "Advantage on saves against being charmed; magic cannot put you to sleep."},
+			{"Skill Versatility", "Gain proficiency in two skills of your choice."},
+		},
+	},
+	"half orc": {
+		Name: "Half-Orc", ASI: map[string]int{"Str": 2, "Con": 1},
+		Size: SizeMedium, Speed: 30, Darkvision: 60, Languages: []string{"Common", "Orc"},
+		SkillProficiencies: []string{SkillIntimidation},
+		Traits: []Trait{
+			{"Darkvision", "See in dim light within 60 feet as if it were bright light."},
+			{"Menacing", "Proficiency in the Intimidation skill."},
+			{"Relentless Endurance", "Drop to 1 hit point instead of 0 once per long rest."},
+			{"Savage Attacks", "Roll one extra weapon damage die on melee critical hits."},
+		},
+	},
+	"tiefling": {
+		Name: "Tiefling", ASI: map[string]int{"Cha": 2, "Int": 1},
+		Size: SizeMedium, Speed: 30, Darkvision: 60, Languages: []string{"Common", "Infernal"},
+		Resistances: []string{"fire"},
+		Traits: []Trait{
+			{"Darkvision", "See in dim light within 60 feet as if it were bright light."},
+			{"Hellish Resistance", "Resistance to fire damage."},
+			{"Infernal Legacy", "Know thaumaturgy; later cast hellish rebuke and darkness once per long rest."},
+		},
+	},
+	"aasimar": {
+		Name: "Aasimar", ASI: map[string]int{"Cha": 2, "Con": 1},
+		Size: SizeMedium, Speed: 30, Darkvision: 60, Languages: []string{"Common", "Celestial"},
+		Resistances: []string{"necrotic", "radiant"},
+		Traits: []Trait{
+			{"Darkvision", "See in dim light within 60 feet as if it were bright light."},
+			{"Celestial Resistance", "Resistance to necrotic and radiant damage."},
+			{"Healing Hands", "Touch a creature to restore hit points equal to your level once per long rest."},
+			{"Light Bearer", "Know the light cantrip."},
+		},
+	},
+	"firbolg": {
+		Name: "Firbolg", ASI: map[string]int{"Wis": 2, "Str": 1},
+		Size: SizeMedium, Speed: 30, Languages: []string{"Common", "Elvish", "Giant"},
+		Traits: []Trait{
+			{"Firbolg Magic", "Cast detect magic and disguise self once per short or long rest."},
+			{"Hidden Step", "Bonus action: turn invisible until your next turn once per short or long rest."},
+			{"Powerful Build", "Count as one size larger for carrying capacity."},
+			{"Speech of Beast and Leaf", "Communicate in a limited manner with beasts and plants."},
+		},
+	},
+	"goliath": {
+		Name: "Goliath", ASI: map[string]int{"Str": 2, "Con": 1},
+		Size: SizeMedium, Speed: 30, Languages: []string{"Common", "Giant"},
+		Resistances:        []string{"cold"},
+		SkillProficiencies: []string{SkillAthletics},
+		Traits: []Trait{
+			{"Natural Athlete", "Proficiency in the Athletics skill."},
+			{"Stone's Endurance", "Reaction: reduce damage by 1d12 + CON modifier once per short or long rest."},
+			{"Powerful Build", "Count as one size larger for carrying capacity."},
+			{"Mountain Born", "Resistance to cold damage and acclimated to high altitude."},
+		},
+	},
+	"tabaxi": {
+		Name: "Tabaxi", ASI: map[string]int{"Dex": 2, "Cha": 1},
+		Size: SizeMedium, Speed: 30, Darkvision: 60, Languages: []string{"Common"}, LanguageChoices: 1,
+		SkillProficiencies: []string{SkillPerception, SkillStealth},
+		Traits: []Trait{
+			{"Darkvision", "See in dim light within 60 feet as if it were bright light."},
+			{"Feline Agility", "Double your speed for one turn; recharges after a turn without moving."},
+			{"Cat's Claws", "Climbing speed of 20 feet and 1d4 slashing unarmed strikes."},
+			{"Cat's Talent", "Proficiency in the Perception and Stealth skills."},
+		},
+	},
+	"triton": {
+		Name: "Triton", ASI: map[string]int{"Str": 1, "Con": 1, "Cha": 1},
+		Size: SizeMedium, Speed: 30, Languages: []string{"Common", "Primordial"},
+		Resistances: []string{"cold"},
+		Traits: []Trait{
+			{"Amphibious", "Breathe air and water; swimming speed of 30 feet."},
+			{"Control Air and Water", "Cast fog cloud, later gust of wind and wall of water."},
+			{"Emissary of the Sea", "Communicate simple ideas with beasts that breathe water."},
+			{"Guardians of the Depths", "Resistance to cold damage and ignore deep water drawbacks."},
+		},
+	},
+}
+
+var raceAliases = map[string]string{
+	"high elf":           "elf high",
+	"wood elf":           "elf wood",
+	"drow":               "elf drow",
+	"mountain dwarf":     "dwarf mountain",
+	"dwarf hill":         "hill dwarf",
+	"halfling lightfoot": "lightfoot halfling",
+	"halfling stout":     "stout halfling",
+	"forest gnome":       "gnome forest",
+	"rock gnome":         "gnome rock",
+	"half-elf":           "half elf",
+	"variant-human":      "variant human",
+	"human variant":      "variant human",
+	"half-orc":           "half orc",
+}
+
+func raceKey(race Race) string {
+	key := strings.ToLower(strings.TrimSpace(string(race)))
+	if alias, ok := raceAliases[key]; ok {
+		return alias
+	}
+	return key
+}
+
+func GetRace(race Race) (RaceData, bool) {
+	data, ok := raceRegistry[raceKey(race)]
+	if !ok {
+		return RaceData{}, false
+	}
+	if data.Parent == "" {
+		return data, true
+	}
+
+	parent := raceRegistry[data.Parent]
+	merged := parent
+	merged.Name = data.Name
+	merged.ASI = map[string]int{}
+	for ability, bonus := range parent.ASI {
+		merged.ASI[ability] += bonus
+	}
+	for ability, bonus := range data.ASI {
+		merged.ASI[ability] += bonus
+	}
+	if data.Speed != 0 {
+		merged.Speed = data.Speed
+	}
+	if data.Darkvision != 0 {
+		merged.Darkvision = data.Darkvision
+	}
+	merged.LanguageChoices += data.LanguageChoices
+	merged.HPPerLevel += data.HPPerLevel
+	merged.Languages = append(append([]string{}, parent.Languages...), data.Languages...)
+	merged.Resistances = append(append([]string{}, parent.Resistances...), data.Resistances...)
+	merged.SkillProficiencies = append(append([]string{}, parent.SkillProficiencies...), data.SkillProficiencies...)
+	merged.ArmorProficiencies = append(append([]string{}, parent.ArmorProficiencies...), data.ArmorProficiencies...)
+	merged.WeaponProficiencies = append(append([]string{}, parent.WeaponProficiencies...), data.WeaponProficiencies...)
+	merged.ToolProficiencies = append(append([]string{}, parent.ToolProficiencies...), data.ToolProficiencies...)
+	merged.Traits = append(append([]Trait{}, parent.Traits...), data.Traits...)
+	return merged, true
+}
+
+func (c *Character) applyRace() {
+	data, ok := GetRace(c.Race)
+	if !ok {
+		if c.BaseSpeed == 0 {
+			c.BaseSpeed = walkingSpeed
+		}
+		return
+	}
+	c.Size = data.Size
+	c.BaseSpeed = data.Speed
+	c.Darkvision = data.Darkvision
+	c.Languages = data.Languages
+	c.Resistances = data.Resistances
+	c.RacialTraits = make([]string, 0, len(data.Traits))
+	for _, t := range data.Traits {
+		c.RacialTraits = append(c.RacialTraits, t.Name)
+	}
+}
+
+func withRacialSkills(race Race, skills []string) []string {
+	data, _ := GetRace(race)
+	result := append([]string{}, skills...)
+	for _, skill := range data.SkillProficiencies {
+		if !NewSkillRepository().HasSkill(result, skill) {
+			result = append(result, skill)
+		}
+	}
+	return result
+}
+
+func ResolveRacialASI(race Race, choices []string, origin map[string]int) (map[string]int, error) {
+	data, ok := GetRace(race)
+	if !ok {
+		return nil, ValidateRace(race)
+	}
+	bonuses := map[string]int{}
+	for ability, bonus := range data.ASI {
+		bonuses[strings.ToUpper(ability)] += bonus
+	}
+
+	if origin != nil {
+		return customOrigin(data, bonuses, origin)
+	}
+
+	if data.ASIChoices == 0 {
+		if len(choices) > 0 {
+			return nil, fmt.Errorf("%s has no racial ability score choices", data.Name)
+		}
+		return bonuses, nil
+	}
+	if len(choices) != data.ASIChoices {
+		return nil, fmt.Errorf("%s chooses %d different abilities for +1%s, got %d",
+			data.Name, data.ASIChoices, excludedAbilities(data), len(choices))
+	}
+	for _, choice := range choices {
+		ability := strings.ToUpper(strings.TrimSpace(choice))
+		switch {
+		case !IsAbility(ability):
+			return nil, fmt.Errorf("unknown ability: %s", choice)
+		case containsFold(data.ASIChoiceExclude, ability):
+			return nil, fmt.Errorf("%s cannot choose %s%s", data.Name, ability, excludedAbilities(data))
+		case bonuses[ability] > 0:
+			return nil, fmt.Errorf("%s already increases %s, choose a different ability", data.Name, ability)
+		}
+		bonuses[ability] = 1
+	}
+	return bonuses, nil
+}
+
+func customOrigin(data RaceData, fixed map[string]int, origin map[string]int) (map[string]int, error) {
+	var expected []int
+	for _, bonus := range fixed {
+		expected = append(expected, bonus)
+	}
+	for i := 0; i < data.ASIChoices; i++ {
+		expected = append(expected, 1)
+	}
+
+	bonuses := map[string]int{}
+	var got []int
+	for ability, bonus := range origin {
+		ability = strings.ToUpper(strings.TrimSpace(ability))
+		if !IsAbility(ability) {
+			return nil, fmt.Errorf("unknown ability: %s", ability)
+		}
+		if _, dup := bonuses[ability]; dup {
+			return nil, fmt.Errorf("%s is assigned more than one increase", ability)
+		}
+		bonuses[ability] = bonus
+		got = append(got, bonus)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(expected)))
+	sort.Sort(sort.Reverse(sort.IntSlice(got)))
+	if fmt.Sprint(expected) != fmt.Sprint(got) {
+		return nil, fmt.Errorf("%s ability score increases are %s, custom origin assigns %s",
+			data.Name, formatIncreases(expected), formatIncreases(got))
+	}
+	return bonuses, nil
+}
+
+func ParseAbilityBonuses(values []string) (map[string]int, error) {
+	bonuses := map[string]int{}
+	for _, value := range values {
+		ability, amount, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("expected ABILITY=N, got %q", value)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(amount))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid increase for %s: %q", ability, amount)
+		}
+		ability = strings.ToUpper(strings.TrimSpace(ability))
+		if _, dup := bonuses[ability]; dup {
+			return nil, fmt.Errorf("%s is assigned more than one increase", ability)
+		}
+		bonuses[ability] = n
+	}
+	return bonuses, nil
+}
+
+func excludedAbilities(data RaceData) string {
+	if len(data.ASIChoiceExclude) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (not %s)", strings.Join(data.ASIChoiceExclude, " or "))
+}
+
+func formatIncreases(values []int) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, fmt.Sprintf("+%d", v))
+	}
+	return strings.Join(parts, ", ")
+}
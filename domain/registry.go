@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const maxSuggestionDistance = 3
+
+type ValidationError struct {
+	Field       string
+	Value       string
+	Suggestions []string
+}
+
+func (e *ValidationError) Error() string {
+	if strings.TrimSpace(e.Value) == "" {
+		return fmt.Sprintf("%s is required", e.Field)
+	}
+	msg := fmt.Sprintf("unknown %s %q", e.Field, e.Value)
+	if len(e.Suggestions) > 0 {
+		msg += fmt.Sprintf(", did you mean: %s?", strings.Join(e.Suggestions, ", "))
+	}
+	return msg
+}
+
+func ValidRaces() []string {
+	races := make([]string, 0, len(raceRegistry))
+	for key := range raceRegistry {
+		races = append(races, key)
+	}
+	sort.Strings(races)
+	return races
+}
+
+func ValidClasses() []string {
+	classes := make([]string, 0, len(classHitDice))
+	for class := range classHitDice {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	return classes
+}
+
+func ValidBackgrounds() []string {
+	backgrounds := NewSkillRepository().Backgrounds()
+	sort.Strings(backgrounds)
+	return backgrounds
+}
+
+func ValidateRace(race Race) error {
+	if _, ok := GetRace(race); ok {
+		return nil
+	}
+	return newValidationError("race", string(race), ValidRaces())
+}
+
+func ValidateClass(class Class) error {
+	key := strings.ToLower(strings.TrimSpace(string(class)))
+	if _, ok := classHitDice[key]; ok {
+		return nil
+	}
+	return newValidationError("class", string(class), ValidClasses())
+}
+
+func ValidateBackground(background string) error {
+	if _, ok := GetBackground(background); ok {
+		return nil
+	}
+	return newValidationError("background", background, ValidBackgrounds())
+}
+
+func newValidationError(field, value string, options []string) *ValidationError {
+	err := &ValidationError{Field: field, Value: value}
+	if strings.TrimSpace(value) != "" {
+		err.Suggestions = ClosestMatches(value, options, maxSuggestionDistance)
+	}
+	return err
+}
+
+func ClosestMatches(value string, options []string, maxDistance int) []string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	best := maxDistance + 1
+	var matches []string
+	for _, option := range options {
+		d := EditDistance(value, option)
+		switch {
+		case d < best:
+			best = d
+			matches = []string{option}
+		case d == best:
+			matches = append(matches, option)
+		}
+	}
+	return matches
+}
+
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	RecoveryShortRest = "short rest"
+	RecoveryLongRest  = "long rest"
+	RecoveryDawn      = "dawn"
+)
+
+type Resource struct {
+	Name          string
+	Uses          map[int]int
+	PerLevel      int
+	Ability       string
+	Bonus         int
+	UnlimitedAt   int
+	Recovery      string
+	ShortRestFrom int
+}
+
+type ClassResource struct {
+	Name      string
+	Max       int
+	Current   int
+	Unlimited bool
+	Recovery  string
+}
+
+func (r Resource) maximum(c *Character, classLevel int) int {
+	uses := r.Bonus + r.PerLevel*classLevel
+	best := 0
+	for lvl := range r.Uses {
+		if lvl <= classLevel && lvl > best {
+			best = lvl
+		}
+	}
+	uses += r.Uses[best]
+	if r.Ability != "" {
+		uses = max(uses+Modifier(c.AbilityScores.Get(r.Ability)), 1)
+	}
+	return uses
+}
+
+func (r Resource) recovery(classLevel int) string {
+	if r.ShortRestFrom > 0 && classLevel >= r.ShortRestFrom {
+		return RecoveryShortRest
+	}
+	return r.Recovery
+}
+
+func (c *Character) Resources() []ClassResource {
+	byName := map[string]ClassResource{}
+	var order []string
+	for _, f := range c.Features() {
+		if f.Resource == nil {
+			continue
+		}
+		name := f.Resource.Name
+		if name == "" {
+			name = f.Name
+		}
+		resource := ClassResource{
+			Name:      name,
+			Max:       f.Resource.maximum(c, f.ClassLevel),
+			Unlimited: f.Resource.UnlimitedAt > 0 && f.ClassLevel >= f.Resource.UnlimitedAt,
+			Recovery:  f.Resource.recovery(f.ClassLevel),
+		}
+		existing, seen := byName[name]
+		if !seen {
+			order = append(order, name)
+		}
+		if !seen || resource.Max > existing.Max || resource.Unlimited {
+			byName[name] = resource
+		}
+	}
+
+	resources := make([]ClassResource, 0, len(order))
+	for _, name := range order {
+		resource := byName[name]
+		if !resource.Unlimited {
+			resource.Current = max(resource.Max-c.ResourcesUsed[name], 0)
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+func (c *Character) Resource(name string) (ClassResource, error) {
+	resources := c.Resources()
+	names := make([]string, 0, len(resources))
+	for _, r := range resources {
+		if strings.EqualFold(r.Name, strings.TrimSpace(name)) {
+			return r, nil
+		}
+		names = append(names, strings.ToLower(r.Name))
+	}
+	return ClassResource{}, newValidationError("resource", name, names)
+}
+
+func (c *Character) UseResource(name string, amount int) (ClassResource, error) {
+	if amount < 1 {
+		return ClassResource{}, fmt.Errorf("amount must be at least 1")
+	}
+	resource, err := c.Resource(name)
+	if err != nil {
+		return ClassResource{}, err
+	}
+	if resource.Unlimited {
+		return resource, nil
+	}
+	if resource.Current < amount {
+		return resource, fmt.Errorf("%s has only %d of %d %s remaining", c.Name, resource.Current, resource.Max, resource.Name)
+	}
+	if c.ResourcesUsed == nil {
+		c.ResourcesUsed = map[string]int{}
+	}
+	c.ResourcesUsed[resource.Name] += amount
+	resource.Current -= amount
+	return resource, nil
+}
+
+func (c *Character) RestoreResource(name string, amount int) (ClassResource, error) {
+	if amount < 0 {
+		return ClassResource{}, fmt.Errorf("amount cannot be negative")
+	}
+	resource, err := c.Resource(name)
+	if err != nil {
+		return ClassResource{}, err
+	}
+	used := c.ResourcesUsed[resource.Name]
+	if amount == 0 || amount >= used {
+		delete(c.ResourcesUsed, resource.Name)
+		amount = used
+	} else {
+		c.ResourcesUsed[resource.Name] = used - amount
+	}
+	resource.Current = min(resource.Current+amount, resource.Max)
+	return resource, nil
+}
+
+func (c *Character) RestoreAllResources() []string {
+	return c.restoreResources(RecoveryShortRest, RecoveryLongRest, RecoveryDawn)
+}
+
+func (c *Character) restoreResources(recoveries ...string) []string {
+	var restored []string
+	for _, resource := range c.Resources() {
+		if c.ResourcesUsed[resource.Name] == 0 {
+			continue
+		}
+		for _, recovery := range recoveries {
+			if resource.Recovery == recovery {
+				delete(c.ResourcesUsed, resource.Name)
+				restored = append(restored, resource.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(restored)
+	return restored
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+type ShortRestOptions struct {
+	HitDice  int
+	Die      int
+	HPMethod string
+	Seed     int64
+}
+
+type RestSummary struct {
+	HPRestored         int
+	HitDiceSpent       map[int]int
+	HitDiceRolls       []int
+	HitDiceRecovered   map[int]int
+	SpellSlotsRestored int
+	PactSlotsRestored  int
+	ExhaustionReduced  bool
+	ResourcesRestored  []string
+}
+
+func (c *Character) SpellSlotsRemaining() map[int]int {
+	remaining := map[int]int{}
+	for level, total := range c.SpellSlots {
+		remaining[level] = max(total-c.SpellSlotsUsed[level], 0)
+	}
+	return remaining
+}
+
+func (c *Character) PactSlotsRemaining() int {
+	return max(c.PactSlots-c.PactSlotsUsed, 0)
+}
+
+func (c *Character) ShortRest(opts ShortRestOptions) (*RestSummary, error) {
+	if opts.HitDice < 0 {
+		return nil, fmt.Errorf("hit dice to spend cannot be negative")
+	}
+	method := strings.ToLower(opts.HPMethod)
+	if method == "" {
+		method = HPMethodAverage
+	}
+	if method != HPMethodAverage && method != HPMethodRoll {
+		return nil, fmt.Errorf("unknown hit point method: %s", opts.HPMethod)
+	}
+
+	remaining := c.HitDiceRemaining()
+	dice, err := chooseHitDice(remaining, opts.HitDice, opts.Die)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &RestSummary{HitDiceSpent: map[int]int{}}
+	r := rand.New(rand.NewSource(opts.Seed))
+	conMod := Modifier(c.AbilityScores.Con)
+	before := c.CurrentHP
+	for _, die := range dice {
+		gain := AverageHitDieGain(die)
+		if method == HPMethodRoll {
+			gain = r.Intn(die) + 1
+			summary.HitDiceRolls = append(summary.HitDiceRolls, gain)
+		}
+		if c.HitDiceSpent == nil {
+			c.HitDiceSpent = map[int]int{}
+		}
+		c.HitDiceSpent[die]++
+		summary.HitDiceSpent[die]++
+		c.CurrentHP = min(c.CurrentHP+max(gain+conMod, 0), c.MaxHP)
+	}
+	summary.HPRestored = c.CurrentHP - before
+
+	summary.PactSlotsRestored = c.PactSlotsUsed
+	c.PactSlotsUsed = 0
+	summary.ResourcesRestored = c.restoreResources(RecoveryShortRest)
+	return summary, nil
+}
+
+func (c *Character) LongRest() (*RestSummary, error) {
+	if c.CurrentHP == 0 {
+		return nil, fmt.Errorf("%s needs at least 1 hit point to benefit from a long rest", c.Name)
+	}
+	if c.Exhaustion >= MaxExhaustion {
+		return nil, fmt.Errorf("%s is dead from exhaustion", c.Name)
+	}
+	summary := &RestSummary{HitDiceRecovered: map[int]int{}}
+	if c.Exhaustion > 0 {
+		c.SetExhaustion(c.Exhaustion - 1)
+		summary.ExhaustionReduced = true
+	}
+
+	summary.HPRestored = c.MaxHP - c.CurrentHP
+	c.CurrentHP = c.MaxHP
+	c.TempHP = 0
+
+	budget := max(len(c.levelHitDice())/2, 1)
+	for _, die := range sortedDice(c.HitDiceSpent) {
+		n := min(c.HitDiceSpent[die], budget)
+		if n == 0 {
+			continue
+		}
+		c.HitDiceSpent[die] -= n
+		summary.HitDiceRecovered[die] = n
+		budget -= n
+		if c.HitDiceSpent[die] == 0 {
+			delete(c.HitDiceSpent, die)
+		}
+	}
+
+	for _, used := range c.SpellSlotsUsed {
+		summary.SpellSlotsRestored += used
+	}
+	c.SpellSlotsUsed = nil
+	summary.PactSlotsRestored = c.PactSlotsUsed
+	c.PactSlotsUsed = 0
+	summary.ResourcesRestored = c.restoreResources(RecoveryShortRest, RecoveryLongRest, RecoveryDawn)
+	return summary, nil
+}
+
+func chooseHitDice(remaining map[int]int, count, die int) ([]int, error) {
+	if die != 0 {
+		if remaining[die] < count {
+			return nil, fmt.Errorf("only %d d%d hit dice remaining, cannot spend %d", remaining[die], die, count)
+		}
+		dice := make([]int, count)
+		for i := range dice {
+			dice[i] = die
+		}
+		return dice, nil
+	}
+
+	total := 0
+	for _, n := range remaining {
+		total += n
+	}
+	if total < count {
+		return nil, fmt.Errorf("only %d hit dice remaining, cannot spend %d", total, count)
+	}
+	var dice []int
+	for _, size := range sortedDice(remaining) {
+		for i := 0; i < remaining[size] && len(dice) < count; i++ {
+			dice = append(dice, size)
+		}
+	}
+	return dice, nil
+}
+
+func sortedDice(dice map[int]int) []int {
+	sizes := make([]int, 0, len(dice))
+	for die := range dice {
+		sizes = append(sizes, die)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(sizes)))
+	return sizes
+}
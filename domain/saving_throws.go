@@ -0,0 +1,65 @@
+package domain
+
+import "strings"
+
+type SavingThrow struct {
+	Ability    string
+	Bonus      int
+	Proficient bool
+}
+
+var classSavingThrows = map[string][]string{
+	"barbarian": {"STR", "CON"},
+	"bard":      {"DEX", "CHA"},
+	"cleric":    {"WIS", "CHA"},
+	"druid":     {"INT", "WIS"},
+	"fighter":   {"STR", "CON"},
+	"monk":      {"STR", "DEX"},
+	"paladin":   {"WIS", "CHA"},
+	"ranger":    {"STR", "DEX"},
+	"rogue":     {"DEX", "INT"},
+	"sorcerer":  {"CON", "CHA"},
+	"warlock":   {"WIS", "CHA"},
+	"wizard":    {"INT", "WIS"},
+}
+
+func ClassSavingThrows(class Class) []string {
+	return append([]string{}, classSavingThrows[strings.ToLower(string(class))]...)
+}
+
+func (c *Character) SavingThrowProficiencies() []string {
+	var proficient []string
+	if levels := c.ClassLevels(); len(levels) > 0 {
+		proficient = ClassSavingThrows(levels[0].Class)
+	}
+	for _, f := range c.Features() {
+		proficient = append(proficient, f.SavingThrows...)
+	}
+	proficient = append(proficient, c.featSavingThrows()...)
+	var ordered []string
+	for _, ability := range AbilityNames {
+		for _, p := range proficient {
+			if p == ability {
+				ordered = append(ordered, ability)
+				break
+			}
+		}
+	}
+	return ordered
+}
+
+func (c *Character) SavingThrows() []SavingThrow {
+	proficient := c.SavingThrowProficiencies()
+	saves := make([]SavingThrow, 0, len(AbilityNames))
+	for _, ability := range AbilityNames {
+		save := SavingThrow{Ability: ability, Bonus: Modifier(c.AbilityScores.Get(ability))}
+		for _, p := range proficient {
+			if p == ability {
+				save.Proficient = true
+				save.Bonus += c.ProficiencyBonus
+			}
+		}
+		saves = append(saves, save)
+	}
+	return saves
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 )
@@ -58,58 +59,174 @@ func (r *SkillRepository) GetAllClassSkills(class string) []string {
 }
 
 func (r *SkillRepository) GetAllBackgroundSkills(background string) []string {
-	backgroundSkills := map[string][]string{
-		"acolyte":       {SkillInsight, SkillReligion},
-		"charlatan":     {SkillDeception, SkillSleightOfHand},
-		"criminal":      {SkillDeception, SkillStealth},
-		"entertainer":   {SkillAcrobatics, SkillPerformance},
-		"folk hero":     {SkillAnimalHandling, SkillSurvival},
-		"guild artisan": {SkillInsight, SkillPersuasion},
-		"hermit":        {SkillMedicine, SkillReligion},
-		"noble":         {SkillHistory, SkillPersuasion},
-		"outlander":     {SkillAthletics, SkillSurvival},
-		"sage":          {SkillArcana, SkillHistory},
-		"sailor":        {SkillAthletics, SkillPerception},
-		"soldier":       {SkillAthletics, SkillIntimidation},
-		"urchin":        {SkillSleightOfHand, SkillStealth},
-	}
-	skills := backgroundSkills[strings.ToLower(background)]
+	data, _ := GetBackground(background)
+	skills := append([]string{}, data.Skills...)
 	sort.Strings(skills)
 	return skills
 }
 
+func (r *SkillRepository) Backgrounds() []string {
+	backgrounds := make([]string, 0, len(backgroundRegistry))
+	for b := range backgroundRegistry {
+		backgrounds = append(backgrounds, b)
+	}
+	return backgrounds
+}
+
+var classSkillCount = map[string]int{
+	"barbarian": 2,
+	"bard":      3,
+	"cleric":    2,
+	"druid":     2,
+	"fighter":   2,
+	"monk":      2,
+	"paladin":   2,
+	"ranger":    3,
+	"rogue":     4,
+	"sorcerer":  2,
+	"warlock":   2,
+	"wizard":    2,
+}
+
+func ClassSkillCount(class string) int {
+	if count, ok := classSkillCount[strings.ToLower(class)]; ok {
+		return count
+	}
+	return 2
+}
+
 func (r *SkillRepository) GetDefaultSkills(class string, background string) []string {
-	classSkills := r.GetAllClassSkills(class)
-	backgroundSkills := r.GetAllBackgroundSkills(background)
+	return r.DefaultClassSkills(class, r.GetAllBackgroundSkills(background))
+}
 
-	classSkillCount := map[string]int{
-		"barbarian": 2,
-		"bard":      3,
-		"cleric":    2,
-		"druid":     2,
-		"fighter":   2,
-		"monk":      2,
-		"paladin":   2,
-		"ranger":    3,
-		"rogue":     4,
-		"sorcerer":  2,
-		"warlock":   2,
-		"wizard":    2,
+func (r *SkillRepository) DefaultClassSkills(class string, granted []string) []string {
+	selected := []string{}
+	for _, skill := range r.GetAllClassSkills(class) {
+		if len(selected) == ClassSkillCount(class) {
+			break
+		}
+		if !r.HasSkill(granted, skill) {
+			selected = append(selected, skill)
+		}
 	}
+	return selected
+}
+
+type SkillOverlapError struct {
+	Background string
+	Skills     []string
+	Options    []string
+}
 
-	count := classSkillCount[strings.ToLower(class)]
-	if count == 0 {
-		count = 2
+func (e *SkillOverlapError) Error() string {
+	return fmt.Sprintf("%s already granted by the %s background, choose %d replacement skill(s) from: %s",
+		strings.Join(e.Skills, ", "), e.Background, len(e.Skills), strings.Join(e.Options, ", "))
+}
+
+func (r *SkillRepository) SelectSkills(class string, background BackgroundData, chosen, replacements []string) ([]string, error) {
+	chosen, err := r.canonicalSkills(chosen)
+	if err != nil {
+		return nil, err
+	}
+	replacements, err = r.canonicalSkills(replacements)
+	if err != nil {
+		return nil, err
 	}
 
+	classSkills := r.GetAllClassSkills(class)
+	if count := ClassSkillCount(class); len(chosen) != count {
+		return nil, fmt.Errorf("%s chooses %d skills from: %s (got %d)", class, count, strings.Join(classSkills, ", "), len(chosen))
+	}
+
+	granted := append([]string{}, background.Skills...)
 	selected := []string{}
-	for i := 0; i < count && i < len(classSkills); i++ {
-		selected = append(selected, strings.ToLower(classSkills[i]))
+	overlaps := []string{}
+	for _, skill := range chosen {
+		if !r.HasSkill(classSkills, skill) {
+			return nil, fmt.Errorf("%s is not a %s skill (choose from: %s)", skill, class, strings.Join(classSkills, ", "))
+		}
+		if r.HasSkill(granted, skill) {
+			overlaps = append(overlaps, skill)
+			continue
+		}
+		selected = append(selected, skill)
 	}
-	for i := 0; i < 2 && i < len(backgroundSkills); i++ {
-		selected = append(selected, strings.ToLower(backgroundSkills[i]))
+
+	taken := append(append([]string{}, selected...), granted...)
+	if len(replacements) != len(overlaps) {
+		if len(overlaps) == 0 {
+			return nil, fmt.Errorf("replacement skills are only allowed for skills already granted by the background")
+		}
+		return nil, &SkillOverlapError{Background: strings.ToLower(background.Name), Skills: overlaps, Options: r.skillsExcept(taken)}
 	}
-	return selected
+	for _, skill := range replacements {
+		if r.HasSkill(taken, skill) {
+			return nil, fmt.Errorf("%s is already a skill proficiency", skill)
+		}
+		selected = append(selected, skill)
+		taken = append(taken, skill)
+	}
+	return append(selected, granted...), nil
+}
+
+func (r *SkillRepository) FindSkill(name string) (Skill, bool) {
+	for _, skill := range r.AllSkills() {
+		if strings.EqualFold(skill.Name, strings.TrimSpace(name)) {
+			return skill, true
+		}
+	}
+	return Skill{}, false
+}
+
+func (r *SkillRepository) CanonicalSkill(name string) (string, bool) {
+	skill, ok := r.FindSkill(name)
+	return skill.Name, ok
+}
+
+func (r *SkillRepository) NormalizeSkills(skills []string) []string {
+	normalized := []string{}
+	for _, skill := range skills {
+		if canonical, ok := r.CanonicalSkill(skill); ok {
+			skill = canonical
+		}
+		if !r.HasSkill(normalized, skill) {
+			normalized = append(normalized, skill)
+		}
+	}
+	return normalized
+}
+
+func (r *SkillRepository) canonicalSkills(names []string) ([]string, error) {
+	skills := []string{}
+	for _, name := range names {
+		skill, ok := r.CanonicalSkill(name)
+		if !ok {
+			return nil, newValidationError("skill", name, r.skillKeys())
+		}
+		if r.HasSkill(skills, skill) {
+			return nil, fmt.Errorf("skill %s chosen more than once", skill)
+		}
+		skills = append(skills, skill)
+	}
+	return skills, nil
+}
+
+func (r *SkillRepository) skillsExcept(taken []string) []string {
+	options := []string{}
+	for _, skill := range r.AllSkills() {
+		if !r.HasSkill(taken, skill.Name) {
+			options = append(options, skill.Name)
+		}
+	}
+	return options
+}
+
+func (r *SkillRepository) skillKeys() []string {
+	keys := []string{}
+	for _, skill := range r.AllSkills() {
+		keys = append(keys, strings.ToLower(skill.Name))
+	}
+	return keys
 }
 
 func (r *SkillRepository) AllSkills() []Skill {
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	copyGoldPerLevel        = 50
+	copyHoursPerLevel       = 2
+	initialSpellbookSpells  = 6
+	spellbookSpellsPerLevel = 2
+)
+
+var ritualSpells = map[string]struct{}{
+	"alarm": {}, "animal messenger": {}, "augury": {}, "commune": {}, "commune with nature": {},
+	"comprehend languages": {}, "contact other plane": {}, "detect magic": {}, "detect poison and disease": {},
+	"divination": {}, "find familiar": {}, "floating disk": {}, "forbiddance": {}, "gentle repose": {},
+	"identify": {}, "illusory script": {}, "instant summons": {}, "locate animals or plants": {},
+	"magic mouth": {}, "meld into stone": {}, "phantom steed": {}, "purify food and drink": {}, "silence": {},
+	"speak with animals": {}, "telepathic bond": {}, "tiny hut": {}, "unseen servant": {},
+	"water breathing": {}, "water walk": {},
+}
+
+type CopyCost struct {
+	Spell Spell
+	Gold  int
+	Hours int
+}
+
+func (s *Spell) IsRitual() bool {
+	_, ok := ritualSpells[strings.ToLower(strings.TrimSpace(s.Name))]
+	return ok
+}
+
+func FreeSpellbookSpells(wizardLevel int) int {
+	if wizardLevel < 1 {
+		return 0
+	}
+	return initialSpellbookSpells + spellbookSpellsPerLevel*(wizardLevel-1)
+}
+
+func SpellCopyCost(spell Spell) CopyCost {
+	return CopyCost{Spell: spell, Gold: copyGoldPerLevel * spell.Level, Hours: copyHoursPerLevel * spell.Level}
+}
+
+func (c *Character) HasSpellbook() bool {
+	return c.HasClass("wizard")
+}
+
+func (c *Character) FreeSpellbookSpellsLeft() int {
+	used := len(c.Spellbook) - c.SpellbookCopies
+	return max(FreeSpellbookSpells(c.ClassLevel("wizard"))-used, 0)
+}
+
+func (c *Character) InSpellbook(name string) (Spell, bool) {
+	for _, s := range c.Spellbook {
+		if s.HasName(strings.TrimSpace(name)) {
+			return s, true
+		}
+	}
+	return Spell{}, false
+}
+
+func (c *Character) usesSpellbook(spell Spell) bool {
+	if spell.Level == 0 || !c.HasSpellbook() {
+		return false
+	}
+	cl, err := c.spellClass(spell)
+	return err == nil && strings.EqualFold(string(cl.Class), "wizard")
+}
+
+func (c *Character) addToSpellbook(spell Spell) error {
+	if _, ok := c.InSpellbook(spell.Name); ok {
+		return fmt.Errorf("spell already known: %s", spell.Name)
+	}
+	if c.FreeSpellbookSpellsLeft() == 0 {
+		return fmt.Errorf("no free spellbook spells left at wizard %d, use copy-spell to add %s", c.ClassLevel("wizard"), spell.Name)
+	}
+	c.Spellbook = append(c.Spellbook, spell)
+	return nil
+}
+
+func (c *Character) CopySpell(spell Spell) (CopyCost, error) {
+	cost := SpellCopyCost(spell)
+	if !c.HasSpellbook() {
+		return cost, fmt.Errorf("%s has no spellbook to copy spells into", c.Name)
+	}
+	if spell.Level == 0 {
+		return cost, fmt.Errorf("cantrips are not copied into a spellbook, learn %s instead", spell.Name)
+	}
+	if _, ok := c.InSpellbook(spell.Name); ok {
+		return cost, fmt.Errorf("%s is already in %s's spellbook", spell.Name, c.Name)
+	}
+	wizardLevel := c.ClassLevel("wizard")
+	if highest := MaxSpellLevel("wizard", wizardLevel); spell.Level > highest {
+		return cost, fmt.Errorf("%s is level %d but wizard %d can only copy spells up to level %d",
+			spell.Name, spell.Level, wizardLevel, highest)
+	}
+	if err := c.Currency.SpendGP(cost.Gold); err != nil {
+		return cost, fmt.Errorf("copying %s %w", spell.Name, err)
+	}
+
+	c.Spellbook = append(c.Spellbook, spell)
+	c.SpellbookCopies++
+	return cost, nil
+}
+
+func (c *Character) ritualFromSpellbook(name string) (Spell, bool) {
+	spell, ok := c.InSpellbook(name)
+	return spell, ok && spell.IsRitual()
+}
+
+func (c *Character) migrateSpellbook() {
+	if !c.HasSpellbook() {
+		return
+	}
+	var kept []Spell
+	for _, s := range c.Spells {
+		if c.usesSpellbook(s) {
+			if _, ok := c.InSpellbook(s.Name); !ok {
+				c.Spellbook = append(c.Spellbook, s)
+			}
+			continue
+		}
+		kept = append(kept, s)
+	}
+	c.Spells = kept
+}
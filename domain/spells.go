@@ -119,3 +119,96 @@ func GetSpellSlots(class Class, level int) map[int]int {
 	}
 	return map[int]int{}
 }
+
+const (
+	CasterFull = "full"
+	CasterHalf = "half"
+	CasterPact = "pact"
+)
+
+var classCasterTypes = map[string]string{
+	"bard": CasterFull, "cleric": CasterFull, "druid": CasterFull,
+	"sorcerer": CasterFull, "wizard": CasterFull,
+	"paladin": CasterHalf, "ranger": CasterHalf,
+	"warlock": CasterPact,
+}
+
+func CasterType(class Class) string {
+	return classCasterTypes[strings.ToLower(string(class))]
+}
+
+var multiclassSpellSlots = [][]int{
+	{2},
+	{3},
+	{4, 2},
+	{4, 3},
+	{4, 3, 2},
+	{4, 3, 3},
+	{4, 3, 3, 1},
+	{4, 3, 3, 2},
+	{4, 3, 3, 3, 1},
+	{4, 3, 3, 3, 2},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 2, 1, 1},
+}
+
+func MulticlassCasterLevel(classes []ClassLevel) int {
+	level := 0
+	for _, cl := range classes {
+		switch CasterType(cl.Class) {
+		case CasterFull:
+			level += cl.Level
+		case CasterHalf:
+			level += cl.Level / 2
+		}
+	}
+	return level
+}
+
+func GetMulticlassSpellSlots(classes []ClassLevel) map[int]int {
+	slots := map[int]int{}
+	var casters []ClassLevel
+	for _, cl := range classes {
+		if cantrips := GetSpellSlots(cl.Class, cl.Level)[0]; cantrips > 0 {
+			slots[0] += cantrips
+		}
+		if t := CasterType(cl.Class); t == CasterFull || t == CasterHalf {
+			casters = append(casters, cl)
+		}
+	}
+
+	switch {
+	case len(casters) == 1:
+		for lvl, count := range GetSpellSlots(casters[0].Class, casters[0].Level) {
+			if lvl > 0 {
+				slots[lvl] = count
+			}
+		}
+	case len(casters) > 1:
+		casterLevel := min(MulticlassCasterLevel(casters), len(multiclassSpellSlots))
+		if casterLevel > 0 {
+			for i, count := range multiclassSpellSlots[casterLevel-1] {
+				slots[i+1] = count
+			}
+		}
+	}
+	return slots
+}
+
+func GetPactSlots(warlockLevel int) (int, int) {
+	slotLevel, count := 0, 0
+	for lvl, n := range GetSpellSlots("warlock", warlockLevel) {
+		if lvl > slotLevel {
+			slotLevel, count = lvl, n
+		}
+	}
+	return slotLevel, count
+}
@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+var spellsKnownTable = map[string][]int{
+	"bard":     {4, 5, 6, 7, 8, 9, 10, 11, 12, 14, 15, 15, 16, 18, 19, 19, 20, 22, 22, 22},
+	"ranger":   {0, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11},
+	"sorcerer": {2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 12, 13, 13, 14, 14, 15, 15, 15, 15},
+	"warlock":  {2, 3, 4, 5, 6, 7, 8, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15},
+}
+
+var cantripsKnownTable = map[string]map[int]int{
+	"bard":     {1: 2, 4: 3, 10: 4},
+	"cleric":   {1: 3, 4: 4, 10: 5},
+	"druid":    {1: 2, 4: 3, 10: 4},
+	"sorcerer": {1: 4, 4: 5, 10: 6},
+	"warlock":  {1: 2, 4: 3, 10: 4},
+	"wizard":   {1: 3, 4: 4, 10: 5},
+}
+
+type SpellLimit struct {
+	Class         Class
+	Cantrips      int
+	MaxCantrips   int
+	Spells        int
+	MaxSpells     int
+	Prepared      int
+	MaxPrepared   int
+	MaxSpellLevel int
+}
+
+func KnowsSpells(class Class) bool {
+	_, ok := spellsKnownTable[strings.ToLower(string(class))]
+	return ok
+}
+
+func SpellsKnown(class Class, level int) int {
+	table, ok := spellsKnownTable[strings.ToLower(string(class))]
+	if !ok || level < 1 {
+		return 0
+	}
+	return table[min(level, len(table))-1]
+}
+
+func CantripsKnown(class Class, level int) int {
+	best := 0
+	table := cantripsKnownTable[strings.ToLower(string(class))]
+	for lvl := range table {
+		if lvl <= level && lvl > best {
+			best = lvl
+		}
+	}
+	return table[best]
+}
+
+func MaxSpellLevel(class Class, level int) int {
+	if CasterType(class) == CasterPact {
+		slotLevel, _ := GetPactSlots(level)
+		return slotLevel
+	}
+	highest := 0
+	for lvl, count := range GetSpellSlots(class, level) {
+		if lvl > highest && count > 0 {
+			highest = lvl
+		}
+	}
+	return highest
+}
+
+func (c *Character) spellClass(spell Spell) (ClassLevel, error) {
+	var casters []ClassLevel
+	for _, cl := range c.ClassLevels() {
+		if IsSpellcastingClass(string(cl.Class)) {
+			casters = append(casters, cl)
+		}
+	}
+	if len(casters) == 0 {
+		return ClassLevel{}, fmt.Errorf("%s cannot cast spells", c.Name)
+	}
+	if len(spell.Class) == 0 {
+		return casters[0], nil
+	}
+	for _, cl := range casters {
+		if containsFold(spell.Class, string(cl.Class)) {
+			return cl, nil
+		}
+	}
+	return ClassLevel{}, fmt.Errorf("%s is not on the %s spell list", spell.Name, c.ClassSummary())
+}
+
+func (c *Character) knownSpellCount(class Class, cantrips bool) int {
+	count := 0
+	for _, s := range c.Spells {
+		if (s.Level == 0) != cantrips {
+			continue
+		}
+		if cl, err := c.spellClass(s); err == nil && strings.EqualFold(string(cl.Class), string(class)) {
+			count++
+		}
+	}
+	return count
+}
+
+func (c *Character) SpellLimits() []SpellLimit {
+	var limits []SpellLimit
+	for _, cl := range c.ClassLevels() {
+		if !IsSpellcastingClass(string(cl.Class)) {
+			continue
+		}
+		limit := SpellLimit{
+			Class:         cl.Class,
+			Cantrips:      c.knownSpellCount(cl.Class, true),
+			MaxCantrips:   CantripsKnown(cl.Class, cl.Level),
+			MaxSpellLevel: MaxSpellLevel(cl.Class, cl.Level),
+		}
+		if KnowsSpells(cl.Class) {
+			limit.Spells = c.knownSpellCount(cl.Class, false)
+			limit.MaxSpells = SpellsKnown(cl.Class, cl.Level)
+		}
+		if PreparesSpells(string(cl.Class)) {
+			limit.Prepared = c.preparedSpellCount(cl.Class)
+			limit.MaxPrepared = c.MaxPreparedSpells(cl.Class)
+		}
+		limits = append(limits, limit)
+	}
+	return limits
+}
+
+func (c *Character) canLearn(spell Spell) (ClassLevel, error) {
+	cl, err := c.spellClass(spell)
+	if err != nil {
+		return cl, err
+	}
+	if spell.Level == 0 {
+		limit := CantripsKnown(cl.Class, cl.Level)
+		if c.knownSpellCount(cl.Class, true) >= limit {
+			return cl, fmt.Errorf("%s %d knows at most %d cantrips", cl.Class, cl.Level, limit)
+		}
+		return cl, nil
+	}
+	if highest := MaxSpellLevel(cl.Class, cl.Level); spell.Level > highest {
+		return cl, fmt.Errorf("%s is level %d but %s %d can only learn spells up to level %d",
+			spell.Name, spell.Level, cl.Class, cl.Level, highest)
+	}
+	if KnowsSpells(cl.Class) {
+		limit := SpellsKnown(cl.Class, cl.Level)
+		if c.knownSpellCount(cl.Class, false) >= limit {
+			return cl, fmt.Errorf("%s %d knows at most %d spells, use swap-spell to replace one", cl.Class, cl.Level, limit)
+		}
+	}
+	return cl, nil
+}
+
+func (c *Character) ForgetSpell(name string) (Spell, error) {
+	spell, ok := c.findSpell(name)
+	if !ok {
+		if spell, ok = c.InSpellbook(name); ok {
+			return spell, fmt.Errorf("%s is written in %s's spellbook and cannot be forgotten", spell.Name, c.Name)
+		}
+		return Spell{}, fmt.Errorf("%s does not know %s", c.Name, name)
+	}
+	if spell.Level == 0 {
+		return spell, fmt.Errorf("cantrips cannot be forgotten")
+	}
+	if c.countsTowardKnownLimit(spell) {
+		if !c.SpellSwapAvailable {
+			return spell, fmt.Errorf("%s can only forget %s after gaining a level, use swap-spell to replace it", c.Name, spell.Name)
+		}
+		c.SpellSwapAvailable = false
+	}
+	c.removeSpell(spell.Name)
+	return spell, nil
+}
+
+func (c *Character) countsTowardKnownLimit(spell Spell) bool {
+	cl, err := c.spellClass(spell)
+	return err == nil && KnowsSpells(cl.Class)
+}
+
+func (c *Character) removeSpell(name string) {
+	for i, s := range c.Spells {
+		if s.HasName(name) {
+			c.Spells = append(c.Spells[:i], c.Spells[i+1:]...)
+			break
+		}
+	}
+	c.UnprepareSpell(name)
+}
+
+func (c *Character) SwapSpell(oldName string, spell Spell) error {
+	if !c.SpellSwapAvailable {
+		return fmt.Errorf("%s can only swap a known spell after gaining a level in a class that knows spells", c.Name)
+	}
+	old, ok := c.findSpell(oldName)
+	if !ok {
+		return fmt.Errorf("%s does not know %s", c.Name, oldName)
+	}
+	if old.Level == 0 || spell.Level == 0 {
+		return fmt.Errorf("cantrips cannot be swapped")
+	}
+	if _, known := c.findSpell(spell.Name); known {
+		return fmt.Errorf("spell already known: %s", spell.Name)
+	}
+
+	c.removeSpell(old.Name)
+	if _, err := c.canLearn(spell); err != nil {
+		c.Spells = append(c.Spells, old)
+		return err
+	}
+	c.Spells = append(c.Spells, spell)
+	c.SpellSwapAvailable = false
+	return nil
+}
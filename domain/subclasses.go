@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Subclass struct {
+	Name        string
+	Class       Class
+	Level       int
+	Description string
+}
+
+var subclassRegistry = map[string][]Subclass{
+	"barbarian": {{Name: "Path of the Berserker", Class: "barbarian", Level: 3, Description: "Primal path of untamed fury"}},
+	"bard":      {{Name: "College of Lore", Class: "bard", Level: 3, Description: "Collectors of knowledge and cutting words"}},
+	"cleric":    {{Name: "Life Domain", Class: "cleric", Level: 1, Description: "Divine domain of healing and vitality"}},
+	"druid":     {{Name: "Circle of the Land", Class: "druid", Level: 2, Description: "Mystics drawing on the magic of the land"}},
+	"fighter":   {{Name: "Champion", Class: "fighter", Level: 3, Description: "Martial archetype of raw physical excellence"}},
+	"monk":      {{Name: "Way of the Open Hand", Class: "monk", Level: 3, Description: "Monastic tradition of unarmed combat mastery"}},
+	"paladin":   {{Name: "Oath of Devotion", Class: "paladin", Level: 3, Description: "Sacred oath of honesty, courage and compassion"}},
+	"ranger":    {{Name: "Hunter", Class: "ranger", Level: 3, Description: "Ranger archetype of monster slayers"}},
+	"rogue":     {{Name: "Thief", Class: "rogue", Level: 3, Description: "Roguish archetype of burglars and treasure hunters"}},
+	"sorcerer":  {{Name: "Draconic Bloodline", Class: "sorcerer", Level: 1, Description: "Sorcerous origin from draconic ancestry"}},
+	"warlock":   {{Name: "The Fiend", Class: "warlock", Level: 1, Description: "Otherworldly patron from the lower planes"}},
+	"wizard":    {{Name: "School of Evocation", Class: "wizard", Level: 2, Description: "Arcane tradition of elemental destruction"}},
+}
+
+func GetSubclasses(class Class) []Subclass {
+	return subclassRegistry[strings.ToLower(string(class))]
+}
+
+func SubclassLevel(class Class) int {
+	subclasses := GetSubclasses(class)
+	if len(subclasses) == 0 {
+		return 0
+	}
+	return subclasses[0].Level
+}
+
+func SubclassNames(class Class) []string {
+	names := []string{}
+	for _, sc := range GetSubclasses(class) {
+		names = append(names, sc.Name)
+	}
+	return names
+}
+
+func FindSubclass(class Class, name string) (*Subclass, error) {
+	subclasses := GetSubclasses(class)
+	if len(subclasses) == 0 {
+		return nil, fmt.Errorf("unknown class: %s", class)
+	}
+	for i := range subclasses {
+		if strings.EqualFold(subclasses[i].Name, strings.TrimSpace(name)) {
+			return &subclasses[i], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown %s subclass %q (available: %s)", class, name, strings.Join(SubclassNames(class), ", "))
+}
+
+func (c *Character) Subclass(class Class) string {
+	for _, cl := range c.ClassLevels() {
+		if strings.EqualFold(string(cl.Class), string(class)) {
+			return cl.Subclass
+		}
+	}
+	return ""
+}
+
+func (c *Character) NeedsSubclass(class Class) bool {
+	level := SubclassLevel(class)
+	return level > 0 && c.ClassLevel(class) >= level && c.Subclass(class) == ""
+}
+
+func (c *Character) ChooseSubclass(class Class, name string) (*Subclass, error) {
+	c.MigrateClasses()
+	if class == "" {
+		class = c.Class
+	}
+	if !c.HasClass(class) {
+		return nil, fmt.Errorf("%s has no levels in %s", c.Name, class)
+	}
+
+	subclass, err := FindSubclass(class, name)
+	if err != nil {
+		return nil, err
+	}
+	if current := c.Subclass(class); current != "" {
+		return nil, fmt.Errorf("%s already follows %s", c.Name, current)
+	}
+	if level := c.ClassLevel(class); level < subclass.Level {
+		return nil, fmt.Errorf("%s is chosen at %s level %d, %s is level %d", subclass.Name, class, subclass.Level, c.Name, level)
+	}
+
+	for i := range c.Classes {
+		if strings.EqualFold(string(c.Classes[i].Class), string(class)) {
+			c.Classes[i].Subclass = subclass.Name
+		}
+	}
+	c.UpdateStats()
+	return subclass, nil
+}
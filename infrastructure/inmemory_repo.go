@@ -34,6 +34,7 @@ func (r *FileCharacterRepo) Save(ctx context.Context, c *domain.Character) error
 		if err := json.Unmarshal(data, &characters); err != nil {
 			return err
 		}
+		migrateCharacters(characters)
 	}
 
 	found := false
@@ -72,6 +73,7 @@ func (r *FileCharacterRepo) List(ctx context.Context) ([]*domain.Character, erro
 	if err := json.Unmarshal(data, &characters); err != nil {
 		return nil, err
 	}
+	migrateCharacters(characters)
 
 	result := make([]*domain.Character, 0, len(characters))
 	for i := range characters {
@@ -92,6 +94,7 @@ func (r *FileCharacterRepo) GetByName(ctx context.Context, name string) (*domain
 	if err := json.Unmarshal(data, &characters); err != nil {
 		return nil, err
 	}
+	migrateCharacters(characters)
 
 	for i := range characters {
 		if strings.EqualFold(characters[i].Name, name) {
@@ -113,6 +116,7 @@ func (r *FileCharacterRepo) Delete(ctx context.Context, name string) error {
 	if err := json.Unmarshal(data, &characters); err != nil {
 		return err
 	}
+	migrateCharacters(characters)
 
 	newList := make([]domain.Character, 0, len(characters))
 	found := false
@@ -148,6 +152,7 @@ func (r *FileCharacterRepo) GetByID(ctx context.Context, id string) (*domain.Cha
 	if err := json.Unmarshal(data, &characters); err != nil {
 		return nil, err
 	}
+	migrateCharacters(characters)
 
 	for i := range characters {
 		if characters[i].ID == id {
@@ -156,3 +161,10 @@ func (r *FileCharacterRepo) GetByID(ctx context.Context, id string) (*domain.Cha
 	}
 	return nil, ErrCharacterNotFound
 }
+
+func migrateCharacters(characters []domain.Character) {
+	for i := range characters {
+		characters[i].MigrateClasses()
+		characters[i].SkillProficiencies = domain.NewSkillRepository().NormalizeSkills(characters[i].SkillProficiencies)
+	}
+}
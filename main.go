@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -21,16 +22,46 @@ const (
 
 func usage() {
 	fmt.Printf(`Usage:
-  %s create -name CHARACTER_NAME -race RACE -class CLASS -level N -str N -dex N -con N -int N -wis N -cha N
-  %s view -name CHARACTER_NAME
-  %s list
-  %s delete -name CHARACTER_NAME
-  %s equip -name CHARACTER_NAME -weapon WEAPON_NAME -slot SLOT
-  %s equip -name CHARACTER_NAME -armor ARMOR_NAME
-  %s equip -name CHARACTER_NAME -shield SHIELD_NAME
-  %s learn-spell -name CHARACTER_NAME -spell SPELL_NAME
-  %s prepare-spell -name CHARACTER_NAME -spell SPELL_NAME 
-`, os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0])
+  %[1]s create -name CHARACTER_NAME -race RACE -class CLASS -background BACKGROUND -level N -str N -dex N -con N -int N -wis N -cha N
+  %[1]s create -name CHARACTER_NAME -race RACE -class CLASS -background BACKGROUND -method pointbuy|array -str N -dex N -con N -int N -wis N -cha N
+  %[1]s create -name CHARACTER_NAME -race RACE -class CLASS -background BACKGROUND -method roll [-seed N]
+  %[1]s create -name CHARACTER_NAME -race RACE -class CLASS -background BACKGROUND -skills "SKILL,SKILL" [-replace "SKILL"]
+  %[1]s create -name CHARACTER_NAME -race RACE -class CLASS -background BACKGROUND -background-random-traits [-seed N]
+  %[1]s create -name CHARACTER_NAME -race RACE -class CLASS -custom-background NAME -background-feature BACKGROUND -background-skills "SKILL,SKILL" [-background-tools "TOOL"] [-background-languages N]
+  %[1]s create -name CHARACTER_NAME -race half-elf|variant-human -class CLASS -background BACKGROUND -racial-asi "ABILITY,ABILITY"
+  %[1]s create -name CHARACTER_NAME -race RACE -class CLASS -background BACKGROUND -custom-origin "ABILITY=N,ABILITY=N"
+  %[1]s view -name CHARACTER_NAME
+  %[1]s races | classes | backgrounds | feats
+  %[1]s list
+  %[1]s delete -name CHARACTER_NAME
+  %[1]s equip -name CHARACTER_NAME -weapon WEAPON_NAME -slot SLOT
+  %[1]s equip -name CHARACTER_NAME -armor ARMOR_NAME
+  %[1]s equip -name CHARACTER_NAME -shield SHIELD_NAME
+  %[1]s learn-spell -name CHARACTER_NAME -spell SPELL_NAME
+  %[1]s copy-spell -name CHARACTER_NAME -spell SPELL_NAME
+  %[1]s prepare-spell -name CHARACTER_NAME -spell SPELL_NAME
+  %[1]s unprepare-spell -name CHARACTER_NAME -spell SPELL_NAME
+  %[1]s forget-spell -name CHARACTER_NAME -spell SPELL_NAME
+  %[1]s swap-spell -name CHARACTER_NAME -spell SPELL_NAME -for SPELL_NAME
+  %[1]s cast -name CHARACTER_NAME -spell SPELL_NAME [-level N]
+  %[1]s damage -name CHARACTER_NAME -amount N
+  %[1]s heal -name CHARACTER_NAME -amount N
+  %[1]s temp-hp -name CHARACTER_NAME -amount N
+  %[1]s level-up -name CHARACTER_NAME [-class CLASS] [-hp average|roll] [-seed N] [-asi ABILITY[,ABILITY] | -feat FEAT [-feat-ability ABILITY]]
+  %[1]s award-xp -name CHARACTER_NAME -amount N
+  %[1]s award-xp -party "NAME,NAME,..." -amount N
+  %[1]s advancement -name CHARACTER_NAME -mode xp|milestone
+  %[1]s choose-subclass -name CHARACTER_NAME [-class CLASS] -subclass SUBCLASS
+  %[1]s expertise -name CHARACTER_NAME -skills "SKILL,SKILL"
+  %[1]s set-profile -name CHARACTER_NAME [-player NAME] [-alignment ALIGNMENT] [-traits "TRAIT|TRAIT"] [-ideal TEXT] [-bond TEXT] [-flaw TEXT]
+  %[1]s set-profile -name CHARACTER_NAME [-appearance TEXT | -appearance-file PATH] [-backstory TEXT | -backstory-file PATH]
+  %[1]s condition add|remove -name CHARACTER_NAME -condition CONDITION
+  %[1]s condition exhaustion -name CHARACTER_NAME -level 0-6
+  %[1]s short-rest -name CHARACTER_NAME [-dice N] [-die SIZE] [-hp average|roll] [-seed N]
+  %[1]s long-rest -name CHARACTER_NAME
+  %[1]s use -name CHARACTER_NAME -resource RESOURCE [-amount N]
+  %[1]s restore -name CHARACTER_NAME [-resource RESOURCE|all] [-amount N]
+`, os.Args[0])
 }
 
 func main() {
@@ -54,6 +85,14 @@ func main() {
 		handleCreate(ctx, charRepo)
 	case "list":
 		handleList(ctx, charRepo)
+	case "races":
+		handleRaces()
+	case "classes":
+		handleClasses()
+	case "backgrounds":
+		handleBackgrounds()
+	case "feats":
+		handleFeats()
 	case "view":
 		handleView(ctx, charRepo)
 	case "delete":
@@ -62,12 +101,50 @@ func main() {
 		handleEquip(ctx, charRepo)
 	case "learn-spell":
 		handleLearnSpell(ctx, charRepo, spellRepo)
+	case "copy-spell":
+		handleCopySpell(ctx, charRepo, spellRepo)
 	case "prepare-spell":
 		handlePrepareSpell(ctx, charRepo, spellRepo)
+	case "unprepare-spell":
+		handleUnprepareSpell(ctx, charRepo)
+	case "forget-spell":
+		handleForgetSpell(ctx, charRepo)
+	case "swap-spell":
+		handleSwapSpell(ctx, charRepo, spellRepo)
+	case "cast":
+		handleCast(ctx, charRepo)
 	case "enrich":
 		services.EnrichData()
 	case "sheet":
 		handleSheet(ctx, charRepo)
+	case "damage":
+		handleDamage(ctx, charRepo)
+	case "heal":
+		handleHeal(ctx, charRepo)
+	case "temp-hp":
+		handleTempHP(ctx, charRepo)
+	case "level-up":
+		handleLevelUp(ctx, charRepo)
+	case "award-xp":
+		handleAwardXP(ctx, charRepo)
+	case "advancement":
+		handleAdvancement(ctx, charRepo)
+	case "choose-subclass":
+		handleChooseSubclass(ctx, charRepo)
+	case "expertise":
+		handleExpertise(ctx, charRepo)
+	case "set-profile":
+		handleSetProfile(ctx, charRepo)
+	case "condition":
+		handleCondition(ctx, charRepo)
+	case "short-rest":
+		handleShortRest(ctx, charRepo)
+	case "long-rest":
+		handleLongRest(ctx, charRepo)
+	case "use":
+		handleUseResource(ctx, charRepo)
+	case "restore":
+		handleRestoreResource(ctx, charRepo)
 	default:
 		usage()
 		os.Exit(2)
@@ -79,7 +156,7 @@ func handleCreate(ctx context.Context, charRepo *infrastructure.FileCharacterRep
 	name := createCmd.String("name", "", CharacterName)
 	race := createCmd.String("race", "", "character race")
 	class := createCmd.String("class", "", "character class")
-	background := createCmd.String("background", "acolyte", "character background")
+	background := createCmd.String("background", "", "character background")
 	level := createCmd.Int("level", 1, "character level")
 	str := createCmd.Int("str", 10, "strength")
 	dex := createCmd.Int("dex", 10, "dexterity")
@@ -87,6 +164,18 @@ func handleCreate(ctx context.Context, charRepo *infrastructure.FileCharacterRep
 	intel := createCmd.Int("int", 10, "intelligence")
 	wis := createCmd.Int("wis", 10, "wisdom")
 	cha := createCmd.Int("cha", 10, "charisma")
+	method := createCmd.String("method", domain.MethodManual, "ability score method (manual, pointbuy, array, roll)")
+	seed := createCmd.Int64("seed", 0, "seed for rolled ability scores")
+	skills := createCmd.String("skills", "", "comma-separated class skills to be proficient in")
+	replace := createCmd.String("replace", "", "comma-separated replacements for skills the background already grants")
+	randomTraits := createCmd.Bool("background-random-traits", false, "roll personality traits, ideal, bond and flaw from the background tables")
+	customBackground := createCmd.String("custom-background", "", "name of a custom background (replaces -background)")
+	backgroundFeature := createCmd.String("background-feature", "", "background whose feature the custom background uses")
+	backgroundSkills := createCmd.String("background-skills", "", "comma-separated two skills granted by the custom background")
+	backgroundTools := createCmd.String("background-tools", "", "comma-separated tool proficiencies granted by the custom background")
+	backgroundLanguages := createCmd.Int("background-languages", 0, "number of languages granted by the custom background")
+	racialASI := createCmd.String("racial-asi", "", "comma-separated abilities for the race's +1 choices (half-elf, variant human)")
+	customOrigin := createCmd.String("custom-origin", "", "reassign racial ability score increases, e.g. dex=2,wis=1")
 
 	if err := createCmd.Parse(os.Args[2:]); err != nil {
 		fmt.Println(ErrParseFlags, err)
@@ -97,31 +186,81 @@ func handleCreate(ctx context.Context, charRepo *infrastructure.FileCharacterRep
 		os.Exit(2)
 	}
 
-	skillRepo := domain.NewSkillRepository()
 	input := services.CreateCharacterInput{
-		Name:       *name,
-		Race:       domain.Race(strings.ToLower(*race)),
-		Class:      domain.Class(strings.ToLower(*class)),
-		Background: *background,
-		Level:      *level,
-		Str:        *str,
-		Dex:        *dex,
-		Con:        *con,
-		Int:        *intel,
-		Wis:        *wis,
-		Cha:        *cha,
-		Skills:     skillRepo.GetDefaultSkills(*class, *background),
+		Name:              *name,
+		Race:              domain.Race(strings.ToLower(*race)),
+		Class:             domain.Class(strings.ToLower(*class)),
+		Background:        *background,
+		Level:             *level,
+		Str:               *str,
+		Dex:               *dex,
+		Con:               *con,
+		Int:               *intel,
+		Wis:               *wis,
+		Cha:               *cha,
+		Method:            strings.ToLower(*method),
+		Seed:              *seed,
+		Skills:            splitList(*skills),
+		SkillReplacements: splitList(*replace),
+		RandomTraits:      *randomTraits,
+		RacialASI:         splitList(*racialASI),
+	}
+	if *customOrigin != "" {
+		origin, err := domain.ParseAbilityBonuses(splitList(*customOrigin))
+		if err != nil {
+			fmt.Println(ErrGeneral, err)
+			os.Exit(2)
+		}
+		input.CustomOrigin = origin
+	}
+	if *customBackground != "" {
+		input.Background = *customBackground
+		input.CustomBackground = &domain.CustomBackgroundOptions{
+			Name:      *customBackground,
+			Feature:   *backgroundFeature,
+			Skills:    splitList(*backgroundSkills),
+			Tools:     splitList(*backgroundTools),
+			Languages: *backgroundLanguages,
+		}
 	}
 	createService := &services.CreateCharacterService{Repo: charRepo}
 	c, err := createService.Execute(ctx, input)
+	var overlap *domain.SkillOverlapError
+	if errors.As(err, &overlap) {
+		fmt.Println(ErrGeneral, err)
+		fmt.Printf("re-run with -replace to pick %d replacement skill(s)\n", len(overlap.Skills))
+		os.Exit(2)
+	}
 	if err != nil {
 		fmt.Println(ErrGeneral, err)
 		os.Exit(2)
 	}
 
+	if c.AbilityMethod == domain.MethodRoll {
+		printAbilityRolls(c.AbilitySeed)
+	}
+	if *randomTraits {
+		printProfile(c.Profile)
+	}
 	fmt.Printf("saved character %s\n", c.Name)
 }
 
+func printAbilityRolls(seed int64) {
+	_, rolls := domain.RollAbilityScores(seed)
+	fmt.Printf("Rolled ability scores (4d6 drop lowest, seed %d):\n", seed)
+	for _, r := range rolls {
+		fmt.Printf("  %s: %v drop %d = %d\n", r.Ability, r.Dice, r.Dropped, r.Total)
+	}
+}
+
+func printProfile(p domain.Profile) {
+	fmt.Printf("Rolled personality (seed %d):\n", p.TraitSeed)
+	for _, trait := range p.PersonalityTraits {
+		fmt.Printf("  Trait: %s\n", trait)
+	}
+	fmt.Printf("  Ideal: %s\n  Bond: %s\n  Flaw: %s\n", p.Ideal, p.Bond, p.Flaw)
+}
+
 func handleList(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
 	list, err := charRepo.List(ctx)
 	if err != nil {
@@ -135,7 +274,43 @@ func handleList(ctx context.Context, charRepo *infrastructure.FileCharacterRepo)
 	fmt.Println("Characters:")
 	for _, c := range list {
 		fmt.Printf("- %s (Race: %s, Class: %s, Level: %d)\n",
-			c.Name, strings.Title(string(c.Race)), strings.Title(string(c.Class)), c.Level)
+			c.Name, strings.Title(string(c.Race)), strings.Title(c.ClassSummary()), c.Level)
+	}
+}
+
+func handleRaces() {
+	fmt.Println("Races:")
+	for _, key := range domain.ValidRaces() {
+		race, _ := domain.GetRace(domain.Race(key))
+		fmt.Printf("- %s (%s)\n", key, race.Name)
+	}
+}
+
+func handleClasses() {
+	fmt.Println("Classes:")
+	for _, class := range domain.ValidClasses() {
+		fmt.Printf("- %s (hit die d%d, subclasses: %s)\n", class, domain.HitDie(domain.Class(class)),
+			strings.Join(domain.SubclassNames(domain.Class(class)), ", "))
+	}
+}
+
+func handleBackgrounds() {
+	fmt.Println("Backgrounds:")
+	for _, key := range domain.ValidBackgrounds() {
+		background, _ := domain.GetBackground(key)
+		fmt.Printf("- %s (skills: %s; feature: %s)\n", key, strings.Join(background.Skills, ", "), background.Feature.Name)
+	}
+}
+
+func handleFeats() {
+	fmt.Println("Feats:")
+	for _, key := range domain.ValidFeats() {
+		feat, _ := domain.GetFeat(key)
+		line := fmt.Sprintf("- %s: %s", key, feat.Description)
+		if prerequisite := feat.Prerequisite.String(); prerequisite != "" {
+			line += fmt.Sprintf(" (requires %s)", prerequisite)
+		}
+		fmt.Println(line)
 	}
 }
 
@@ -239,6 +414,99 @@ func handleLearnSpell(ctx context.Context, charRepo *infrastructure.FileCharacte
 	}
 }
 
+func handleCopySpell(ctx context.Context, charRepo *infrastructure.FileCharacterRepo, spellRepo *infrastructure.SpellRepository) {
+	copyCmd := flag.NewFlagSet("copy-spell", flag.ExitOnError)
+	name := copyCmd.String("name", "", CharacterName)
+	spell := copyCmd.String("spell", "", "Spell name")
+
+	if err := copyCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" || *spell == "" {
+		fmt.Println(NameAndSpellReq)
+		os.Exit(1)
+	}
+
+	copyService := &services.CopySpellService{Repo: charRepo, SpellRepo: spellRepo}
+	output, err := copyService.Execute(ctx, *name, *spell)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleUnprepareSpell(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	unprepareCmd := flag.NewFlagSet("unprepare-spell", flag.ExitOnError)
+	name := unprepareCmd.String("name", "", CharacterName)
+	spell := unprepareCmd.String("spell", "", "Spell name")
+
+	if err := unprepareCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" || *spell == "" {
+		fmt.Println(NameAndSpellReq)
+		os.Exit(1)
+	}
+
+	unprepareService := &services.UnprepareSpellService{Repo: charRepo}
+	output, err := unprepareService.Execute(ctx, *name, *spell)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleForgetSpell(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	forgetCmd := flag.NewFlagSet("forget-spell", flag.ExitOnError)
+	name := forgetCmd.String("name", "", CharacterName)
+	spell := forgetCmd.String("spell", "", "Spell name")
+
+	if err := forgetCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" || *spell == "" {
+		fmt.Println(NameAndSpellReq)
+		os.Exit(1)
+	}
+
+	forgetService := &services.ForgetSpellService{Repo: charRepo}
+	output, err := forgetService.Execute(ctx, *name, *spell)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleSwapSpell(ctx context.Context, charRepo *infrastructure.FileCharacterRepo, spellRepo *infrastructure.SpellRepository) {
+	swapCmd := flag.NewFlagSet("swap-spell", flag.ExitOnError)
+	name := swapCmd.String("name", "", CharacterName)
+	spell := swapCmd.String("spell", "", "Known spell to replace")
+	replacement := swapCmd.String("for", "", "Spell to learn in its place")
+
+	if err := swapCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" || *spell == "" || *replacement == "" {
+		fmt.Println("Error: -name, -spell and -for are required")
+		os.Exit(1)
+	}
+
+	swapService := &services.SwapSpellService{Repo: charRepo, SpellRepo: spellRepo}
+	output, err := swapService.Execute(ctx, *name, *spell, *replacement)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
 func handlePrepareSpell(ctx context.Context, charRepo *infrastructure.FileCharacterRepo, spellRepo *infrastructure.SpellRepository) {
 	prepareCmd := flag.NewFlagSet("prepare-spell", flag.ExitOnError)
 	name := prepareCmd.String("name", "", CharacterName)
@@ -288,3 +556,424 @@ func handleSheet(ctx context.Context, charRepo *infrastructure.FileCharacterRepo
 
 	fmt.Println(output)
 }
+
+func parseNameAndAmount(cmdName string) (string, int) {
+	cmd := flag.NewFlagSet(cmdName, flag.ExitOnError)
+	name := cmd.String("name", "", CharacterName)
+	amount := cmd.Int("amount", 0, "Number of hit points")
+
+	if err := cmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" {
+		fmt.Println(NameRequired)
+		os.Exit(1)
+	}
+	return *name, *amount
+}
+
+func handleDamage(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	name, amount := parseNameAndAmount("damage")
+	damageService := &services.DamageService{Repo: charRepo}
+	output, err := damageService.Execute(ctx, name, amount)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleHeal(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	name, amount := parseNameAndAmount("heal")
+	healService := &services.HealService{Repo: charRepo}
+	output, err := healService.Execute(ctx, name, amount)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleTempHP(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	name, amount := parseNameAndAmount("temp-hp")
+	tempHPService := &services.TempHPService{Repo: charRepo}
+	output, err := tempHPService.Execute(ctx, name, amount)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleLevelUp(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	levelUpCmd := flag.NewFlagSet("level-up", flag.ExitOnError)
+	name := levelUpCmd.String("name", "", CharacterName)
+	class := levelUpCmd.String("class", "", "Class to gain a level in (defaults to the first class)")
+	hp := levelUpCmd.String("hp", domain.HPMethodAverage, "Hit point method (average, roll)")
+	seed := levelUpCmd.Int64("seed", 0, "Seed for rolled hit points")
+	asi := levelUpCmd.String("asi", "", "Ability score increase: one ability for +2 or two for +1 each")
+	feat := levelUpCmd.String("feat", "", "Feat to take instead of an ability score increase")
+	featAbility := levelUpCmd.String("feat-ability", "", "Ability to increase for feats that offer a choice")
+
+	if err := levelUpCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" {
+		fmt.Println(NameRequired)
+		os.Exit(1)
+	}
+
+	opts := domain.LevelUpOptions{
+		Class:       domain.Class(strings.ToLower(*class)),
+		HPMethod:    *hp,
+		Seed:        *seed,
+		ASI:         splitList(*asi),
+		Feat:        *feat,
+		FeatAbility: *featAbility,
+	}
+	levelUpService := &services.LevelUpService{Repo: charRepo}
+	output, err := levelUpService.Execute(ctx, *name, opts)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func handleAwardXP(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	awardCmd := flag.NewFlagSet("award-xp", flag.ExitOnError)
+	name := awardCmd.String("name", "", CharacterName)
+	party := awardCmd.String("party", "", "Comma separated character names sharing the XP")
+	amount := awardCmd.Int("amount", 0, "Experience points to award")
+
+	if err := awardCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+
+	awardService := &services.AwardXPService{Repo: charRepo}
+	var output string
+	var err error
+
+	switch {
+	case *party != "":
+		output, err = awardService.ExecuteParty(ctx, splitList(*party), *amount)
+	case *name != "":
+		output, err = awardService.Execute(ctx, *name, *amount)
+	default:
+		fmt.Println("Error: -name or -party is required")
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleAdvancement(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	advancementCmd := flag.NewFlagSet("advancement", flag.ExitOnError)
+	name := advancementCmd.String("name", "", CharacterName)
+	mode := advancementCmd.String("mode", "", "Advancement mode (xp, milestone)")
+
+	if err := advancementCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" {
+		fmt.Println(NameRequired)
+		os.Exit(1)
+	}
+
+	advancementService := &services.AdvancementService{Repo: charRepo}
+	output, err := advancementService.Execute(ctx, *name, *mode)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleChooseSubclass(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	subclassCmd := flag.NewFlagSet("choose-subclass", flag.ExitOnError)
+	name := subclassCmd.String("name", "", CharacterName)
+	class := subclassCmd.String("class", "", "Class the subclass belongs to (defaults to the first class)")
+	subclass := subclassCmd.String("subclass", "", "Subclass name")
+
+	if err := subclassCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" || *subclass == "" {
+		fmt.Println("Error: -name and -subclass are required")
+		os.Exit(1)
+	}
+
+	subclassService := &services.ChooseSubclassService{Repo: charRepo}
+	output, err := subclassService.Execute(ctx, *name, domain.Class(strings.ToLower(*class)), *subclass)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleExpertise(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	expertiseCmd := flag.NewFlagSet("expertise", flag.ExitOnError)
+	name := expertiseCmd.String("name", "", CharacterName)
+	skills := expertiseCmd.String("skills", "", "Comma-separated proficient skills to gain expertise in")
+
+	if err := expertiseCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" || *skills == "" {
+		fmt.Println("Error: -name and -skills are required")
+		os.Exit(1)
+	}
+
+	expertiseService := &services.ChooseExpertiseService{Repo: charRepo}
+	output, err := expertiseService.Execute(ctx, *name, splitList(*skills))
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleSetProfile(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	profileCmd := flag.NewFlagSet("set-profile", flag.ExitOnError)
+	name := profileCmd.String("name", "", CharacterName)
+	player := profileCmd.String("player", "", "Player name")
+	alignment := profileCmd.String("alignment", "", "Alignment (e.g. lawful good, CN, unaligned)")
+	traits := profileCmd.String("traits", "", "Personality traits separated by |")
+	ideal := profileCmd.String("ideal", "", "Ideal")
+	bond := profileCmd.String("bond", "", "Bond")
+	flaw := profileCmd.String("flaw", "", "Flaw")
+	appearance := profileCmd.String("appearance", "", "Appearance description")
+	appearanceFile := profileCmd.String("appearance-file", "", "Read the appearance description from a file")
+	backstory := profileCmd.String("backstory", "", "Backstory")
+	backstoryFile := profileCmd.String("backstory-file", "", "Read the backstory from a file")
+
+	if err := profileCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" {
+		fmt.Println(NameRequired)
+		os.Exit(1)
+	}
+
+	update := domain.ProfileUpdate{
+		PlayerName: *player,
+		Alignment:  *alignment,
+		Ideal:      *ideal,
+		Bond:       *bond,
+		Flaw:       *flaw,
+		Appearance: readTextFlag(*appearance, *appearanceFile),
+		Backstory:  readTextFlag(*backstory, *backstoryFile),
+	}
+	for _, trait := range strings.Split(*traits, "|") {
+		if trait = strings.TrimSpace(trait); trait != "" {
+			update.PersonalityTraits = append(update.PersonalityTraits, trait)
+		}
+	}
+
+	profileService := &services.SetProfileService{Repo: charRepo}
+	output, err := profileService.Execute(ctx, *name, update)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func readTextFlag(value, path string) string {
+	if path == "" {
+		return value
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func handleCondition(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	if len(os.Args) < 3 {
+		usage()
+		os.Exit(2)
+	}
+	action := os.Args[2]
+	conditionCmd := flag.NewFlagSet("condition "+action, flag.ExitOnError)
+	name := conditionCmd.String("name", "", CharacterName)
+	condition := conditionCmd.String("condition", "", "Condition name (e.g. poisoned, prone, exhaustion)")
+	level := conditionCmd.Int("level", -1, "Exhaustion level (0-6)")
+
+	if err := conditionCmd.Parse(os.Args[3:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" {
+		fmt.Println(NameRequired)
+		os.Exit(1)
+	}
+
+	var output string
+	var err error
+	if action == "exhaustion" {
+		if *level < 0 {
+			fmt.Println("Error: -level is required")
+			os.Exit(1)
+		}
+		exhaustionService := &services.ExhaustionService{Repo: charRepo}
+		output, err = exhaustionService.Execute(ctx, *name, *level)
+	} else {
+		if *condition == "" {
+			fmt.Println("Error: -condition is required")
+			os.Exit(1)
+		}
+		conditionService := &services.ConditionService{Repo: charRepo}
+		output, err = conditionService.Execute(ctx, *name, action, *condition)
+	}
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleShortRest(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	restCmd := flag.NewFlagSet("short-rest", flag.ExitOnError)
+	name := restCmd.String("name", "", CharacterName)
+	dice := restCmd.Int("dice", 0, "Number of hit dice to spend")
+	die := restCmd.Int("die", 0, "Hit die size to spend (defaults to the largest available)")
+	hp := restCmd.String("hp", domain.HPMethodAverage, "Hit point method (average, roll)")
+	seed := restCmd.Int64("seed", 0, "Seed for rolled hit dice")
+
+	if err := restCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" {
+		fmt.Println(NameRequired)
+		os.Exit(1)
+	}
+
+	opts := domain.ShortRestOptions{HitDice: *dice, Die: *die, HPMethod: *hp, Seed: *seed}
+	restService := &services.ShortRestService{Repo: charRepo}
+	output, err := restService.Execute(ctx, *name, opts)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleLongRest(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	restCmd := flag.NewFlagSet("long-rest", flag.ExitOnError)
+	name := restCmd.String("name", "", CharacterName)
+
+	if err := restCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" {
+		fmt.Println(NameRequired)
+		os.Exit(1)
+	}
+
+	restService := &services.LongRestService{Repo: charRepo}
+	output, err := restService.Execute(ctx, *name)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleUseResource(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	useCmd := flag.NewFlagSet("use", flag.ExitOnError)
+	name := useCmd.String("name", "", CharacterName)
+	resource := useCmd.String("resource", "", "Class resource to spend (e.g. rage, ki, sorcery points)")
+	amount := useCmd.Int("amount", 1, "Number of uses or points to spend")
+
+	if err := useCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" || *resource == "" {
+		fmt.Println("Error: -name and -resource are required")
+		os.Exit(1)
+	}
+
+	useService := &services.UseResourceService{Repo: charRepo}
+	output, err := useService.Execute(ctx, *name, *resource, *amount)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleRestoreResource(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	restoreCmd := flag.NewFlagSet("restore", flag.ExitOnError)
+	name := restoreCmd.String("name", "", CharacterName)
+	resource := restoreCmd.String("resource", "all", "Class resource to restore, or all")
+	amount := restoreCmd.Int("amount", 0, "Number of uses or points to restore (defaults to all)")
+
+	if err := restoreCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" {
+		fmt.Println(NameRequired)
+		os.Exit(1)
+	}
+
+	restoreService := &services.RestoreResourceService{Repo: charRepo}
+	output, err := restoreService.Execute(ctx, *name, *resource, *amount)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
+
+func handleCast(ctx context.Context, charRepo *infrastructure.FileCharacterRepo) {
+	castCmd := flag.NewFlagSet("cast", flag.ExitOnError)
+	name := castCmd.String("name", "", CharacterName)
+	spell := castCmd.String("spell", "", "Name of the spell to cast")
+	level := castCmd.Int("level", 0, "Spell slot level to use (defaults to the lowest available)")
+
+	if err := castCmd.Parse(os.Args[2:]); err != nil {
+		fmt.Println(ErrParseFlags, err)
+		os.Exit(2)
+	}
+	if *name == "" || *spell == "" {
+		fmt.Println("Error: -name and -spell are required")
+		os.Exit(1)
+	}
+
+	castService := &services.CastSpellService{Repo: charRepo}
+	output, err := castService.Execute(ctx, *name, *spell, *level)
+	if err != nil {
+		fmt.Println(ErrGeneral, err)
+		os.Exit(1)
+	}
+	fmt.Println(output)
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"starter_pack/domain"
+)
+
+type AwardXPService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *AwardXPService) Execute(ctx context.Context, name string, amount int) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+
+	if err := char.AwardXP(amount); err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return formatXPAward(char, amount), nil
+}
+
+func (s *AwardXPService) ExecuteParty(ctx context.Context, names []string, amount int) (string, error) {
+	if len(names) == 0 {
+		return "", fmt.Errorf("party has no members")
+	}
+	if amount < 0 {
+		return "", fmt.Errorf("experience points cannot be negative")
+	}
+
+	party := make([]*domain.Character, 0, len(names))
+	seen := make([]string, 0, len(names))
+	for _, name := range names {
+		if slices.ContainsFunc(seen, func(n string) bool { return strings.EqualFold(n, name) }) {
+			continue
+		}
+		seen = append(seen, name)
+		char, err := s.Repo.GetByName(ctx, name)
+		if err != nil {
+			return "", fmt.Errorf("character not found: %s: %w", name, err)
+		}
+		if char.UsesMilestones() {
+			return "", fmt.Errorf("%s uses milestone leveling, experience points are disabled", char.Name)
+		}
+		party = append(party, char)
+	}
+
+	share := amount / len(party)
+	summary := fmt.Sprintf("Split %d XP between %d characters (%d each)", amount, len(party), share)
+	if remainder := amount % len(party); remainder > 0 {
+		summary += fmt.Sprintf(", %d XP left undistributed", remainder)
+	}
+	lines := make([]string, 0, len(party)+1)
+	lines = append(lines, summary)
+	for _, char := range party {
+		if err := char.AwardXP(share); err != nil {
+			return "", err
+		}
+		if err := s.Repo.Save(ctx, char); err != nil {
+			return "", fmt.Errorf("failed to save character: %w", err)
+		}
+		lines = append(lines, formatXPAward(char, share))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+type AdvancementService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *AdvancementService) Execute(ctx context.Context, name string, mode string) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+
+	if err := char.SetAdvancement(mode); err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	if char.UsesMilestones() {
+		return fmt.Sprintf("%s now uses milestone leveling", char.Name), nil
+	}
+	return fmt.Sprintf("%s now uses experience points", char.Name), nil
+}
+
+func formatXPAward(c *domain.Character, amount int) string {
+	msg := fmt.Sprintf("%s gains %d XP (total %d)", c.Name, amount, c.Experience)
+	if c.LevelUpAvailable() {
+		msg += fmt.Sprintf(", reached the threshold for level %d: run level-up", domain.LevelForXP(c.Experience))
+	}
+	return msg
+}
+
+func formatExperience(c *domain.Character) string {
+	if c.UsesMilestones() {
+		return "milestone leveling"
+	}
+	if c.Level >= domain.MaxLevel {
+		return fmt.Sprintf("%d", c.Experience)
+	}
+	return fmt.Sprintf("%d (next level at %d)", c.Experience, domain.XPForLevel(c.Level+1))
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func TestAwardXPServiceFlagsLevelThreshold(t *testing.T) {
+	char := &domain.Character{Name: "Frodo", Class: "Rogue", Level: 1, Experience: 250}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Frodo": char}}
+
+	service := &AwardXPService{Repo: repo}
+	msg, err := service.Execute(context.Background(), "Frodo", 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.Experience != 350 {
+		t.Errorf("expected 350 XP, got %d", char.Experience)
+	}
+	if !strings.Contains(msg, "level 2") {
+		t.Errorf("expected level threshold in message, got %s", msg)
+	}
+}
+
+func TestAwardXPServicePartySplit(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{
+		"Frodo": {Name: "Frodo", Level: 1},
+		"Sam":   {Name: "Sam", Level: 1},
+		"Merry": {Name: "Merry", Level: 1},
+	}}
+
+	service := &AwardXPService{Repo: repo}
+	msg, err := service.ExecuteParty(context.Background(), []string{"Frodo", "Sam", "Merry"}, 1000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, c := range repo.Characters {
+		if c.Experience != 333 {
+			t.Errorf("expected %s to have 333 XP, got %d", name, c.Experience)
+		}
+	}
+	if !strings.Contains(msg, "1 XP left undistributed") {
+		t.Errorf("expected undistributed remainder in message, got %s", msg)
+	}
+}
+
+func TestAwardXPServicePartyIgnoresDuplicates(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{
+		"Frodo": {Name: "Frodo", Level: 1},
+		"Sam":   {Name: "Sam", Level: 1},
+	}}
+
+	service := &AwardXPService{Repo: repo}
+	msg, err := service.ExecuteParty(context.Background(), []string{"Frodo", "Sam", "Frodo"}, 500)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.Characters["Frodo"].Experience != 250 || repo.Characters["Sam"].Experience != 250 {
+		t.Errorf("expected 250 XP each, got Frodo %d and Sam %d",
+			repo.Characters["Frodo"].Experience, repo.Characters["Sam"].Experience)
+	}
+	if !strings.Contains(msg, "between 2 characters") || strings.Contains(msg, "undistributed") {
+		t.Errorf("unexpected message: %s", msg)
+	}
+}
+
+func TestAwardXPServiceMilestone(t *testing.T) {
+	char := &domain.Character{Name: "Aragorn", Level: 5}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Aragorn": char}}
+
+	advancement := &AdvancementService{Repo: repo}
+	if _, err := advancement.Execute(context.Background(), "Aragorn", "milestone"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	service := &AwardXPService{Repo: repo}
+	if _, err := service.Execute(context.Background(), "Aragorn", 500); err == nil {
+		t.Fatalf("expected error when awarding XP to milestone character")
+	}
+	if _, err := advancement.Execute(context.Background(), "Aragorn", "sometimes"); err == nil {
+		t.Errorf("expected error for unknown advancement mode")
+	}
+}
+
+func TestLevelForXP(t *testing.T) {
+	tests := map[int]int{0: 1, 299: 1, 300: 2, 6500: 5, 354999: 19, 400000: 20}
+	for xp, want := range tests {
+		if got := domain.LevelForXP(xp); got != want {
+			t.Errorf("LevelForXP(%d) = %d, want %d", xp, got, want)
+		}
+	}
+}
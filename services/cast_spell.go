@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"starter_pack/domain"
+)
+
+type CastSpellService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *CastSpellService) Execute(ctx context.Context, name, spellName string, level int) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	result, err := char.CastSpell(spellName, level)
+	if err != nil {
+		return "", fmt.Errorf("cannot cast %s: %w", spellName, err)
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return formatCastResult(char, result), nil
+}
+
+func formatCastResult(c *domain.Character, result domain.CastResult) string {
+	msg := fmt.Sprintf("%s casts %s", c.Name, result.Spell.Name)
+	switch {
+	case result.Ritual:
+		return msg + " as a ritual (10 extra minutes, no slot used)"
+	case result.Spell.Level == 0:
+		return msg + " (cantrip, no slot used)"
+	case result.Pact:
+		msg += fmt.Sprintf(" with a level %d pact slot", result.SlotLevel)
+	default:
+		msg += fmt.Sprintf(" with a level %d slot", result.SlotLevel)
+	}
+	if result.Upcast() {
+		msg += fmt.Sprintf(" (upcast from level %d)", result.Spell.Level)
+	}
+	if result.Pact {
+		return msg + fmt.Sprintf(", %d/%d pact slots remaining", c.PactSlotsRemaining(), c.PactSlots)
+	}
+	return msg + fmt.Sprintf(", %d/%d level %d slots remaining",
+		c.SpellSlotsRemaining()[result.SlotLevel], c.SpellSlots[result.SlotLevel], result.SlotLevel)
+}
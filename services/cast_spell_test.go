@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func TestCastSpellServiceSpendsSlots(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Jozan",
+		Class:         "cleric",
+		Level:         3,
+		AbilityScores: domain.AbilityScores{Wis: 16},
+		Spells: []domain.Spell{
+			{Name: "Sacred Flame", Level: 0, Class: []string{"cleric"}},
+		},
+		PreparedSpells: []domain.Spell{
+			{Name: "Cure Wounds", Level: 1, Class: []string{"cleric"}},
+			{Name: "Spiritual Weapon", Level: 2, Class: []string{"cleric"}},
+		},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Jozan": char}}
+	service := &CastSpellService{Repo: repo}
+
+	output, err := service.Execute(context.Background(), "Jozan", "cure wounds", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "Jozan casts Cure Wounds with a level 2 slot (upcast from level 1), 1/2 level 2 slots remaining" {
+		t.Errorf("unexpected output %q", output)
+	}
+
+	output, err = service.Execute(context.Background(), "Jozan", "Sacred Flame", 0)
+	if err != nil || !strings.Contains(output, "cantrip, no slot used") {
+		t.Errorf("expected cantrip without a slot, got %q (%v)", output, err)
+	}
+	if char.SpellSlotsUsed[0] != 0 {
+		t.Errorf("expected cantrips not to consume slots, got %v", char.SpellSlotsUsed)
+	}
+
+	if _, err := service.Execute(context.Background(), "Jozan", "Spiritual Weapon", 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := service.Execute(context.Background(), "Jozan", "Spiritual Weapon", 0); err == nil || !strings.Contains(err.Error(), "no spell slots of level 2 or higher left") {
+		t.Errorf("expected refusal without slots, got %v", err)
+	}
+	if _, err := service.Execute(context.Background(), "Jozan", "Spiritual Weapon", 1); err == nil {
+		t.Errorf("expected error casting below the spell's level")
+	}
+	if _, err := service.Execute(context.Background(), "Jozan", "Fireball", 0); err == nil || !strings.Contains(err.Error(), "has not prepared or learned") {
+		t.Errorf("expected error for an unknown spell, got %v", err)
+	}
+
+	output, err = service.Execute(context.Background(), "Jozan", "Cure Wounds", 0)
+	if err != nil || !strings.Contains(output, "3/4 level 1 slots remaining") {
+		t.Errorf("expected lowest slot used, got %q (%v)", output, err)
+	}
+}
+
+func TestCastSpellServiceUsesPactSlots(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Hexa",
+		Class:         "warlock",
+		Level:         5,
+		AbilityScores: domain.AbilityScores{Cha: 16},
+		Spells:        []domain.Spell{{Name: "Hex", Level: 1, Class: []string{"warlock"}}},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Hexa": char}}
+	service := &CastSpellService{Repo: repo}
+
+	output, err := service.Execute(context.Background(), "Hexa", "Hex", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "Hexa casts Hex with a level 3 pact slot (upcast from level 1), 1/2 pact slots remaining" {
+		t.Errorf("unexpected output %q", output)
+	}
+	if _, err := service.Execute(context.Background(), "Hexa", "Hex", 2); err == nil {
+		t.Errorf("expected error casting below the pact slot level")
+	}
+	if _, err := service.Execute(context.Background(), "Hexa", "Hex", 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := service.Execute(context.Background(), "Hexa", "Hex", 0); err == nil {
+		t.Errorf("expected refusal once pact slots are spent")
+	}
+
+	if _, err := (&ShortRestService{Repo: repo}).Execute(context.Background(), "Hexa", domain.ShortRestOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.PactSlotsRemaining() != 2 {
+		t.Errorf("expected pact slots back after a short rest, got %d", char.PactSlotsRemaining())
+	}
+}
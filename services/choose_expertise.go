@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"starter_pack/domain"
+)
+
+type ChooseExpertiseService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *ChooseExpertiseService) Execute(ctx context.Context, name string, skills []string) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+
+	chosen, err := char.ChooseExpertise(skills)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("%s gained expertise in %s", char.Name, strings.Join(chosen, ", ")), nil
+}
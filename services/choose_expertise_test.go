@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func TestChooseExpertiseServiceDoublesProficiency(t *testing.T) {
+	char := &domain.Character{
+		Name:               "Lidda",
+		Class:              "rogue",
+		Level:              1,
+		AbilityScores:      domain.AbilityScores{Str: 10, Dex: 16, Con: 12, Int: 10, Wis: 12, Cha: 10},
+		SkillProficiencies: []string{"Stealth", "Perception", "Acrobatics", "Insight"},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Lidda": char}}
+	service := &ChooseExpertiseService{Repo: repo}
+
+	msg, err := service.Execute(context.Background(), "Lidda", []string{"stealth", "perception"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg != "Lidda gained expertise in Stealth, Perception" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	char.UpdateStats()
+	if got := char.SkillProficiency("Stealth"); got != domain.ProficiencyExpertise {
+		t.Errorf("expected expertise, got %s", got)
+	}
+	if got := char.SkillBonus(domain.Skill{Name: "Stealth", Ability: "DEX"}); got != 7 {
+		t.Errorf("expected Stealth +7, got %+d", got)
+	}
+	if got := char.SkillBonus(domain.Skill{Name: "Acrobatics", Ability: "DEX"}); got != 5 {
+		t.Errorf("expected Acrobatics +5, got %+d", got)
+	}
+
+	if _, err := service.Execute(context.Background(), "Lidda", []string{"Acrobatics"}); err == nil {
+		t.Errorf("expected error when no expertise choices remain")
+	}
+
+	sheet := &CharacterSheetService{Repo: repo}
+	output, err := sheet.Execute(context.Background(), "Lidda", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "[x] Stealth (DEX) +7 (expertise)") {
+		t.Errorf("expected expertise on sheet, got:\n%s", output)
+	}
+}
+
+func TestChooseExpertiseServiceValidation(t *testing.T) {
+	rogue := &domain.Character{Name: "Lidda", Class: "rogue", Level: 1, SkillProficiencies: []string{"Stealth"}}
+	fighter := &domain.Character{Name: "Tordek", Class: "fighter", Level: 5, SkillProficiencies: []string{"Athletics"}}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Lidda": rogue, "Tordek": fighter}}
+	service := &ChooseExpertiseService{Repo: repo}
+
+	if _, err := service.Execute(context.Background(), "Lidda", []string{"Arcana"}); err == nil {
+		t.Errorf("expected error for skill without proficiency")
+	}
+	if _, err := service.Execute(context.Background(), "Lidda", []string{"Stealth", "Stealth"}); err == nil {
+		t.Errorf("expected error for duplicate skill")
+	}
+	if _, err := service.Execute(context.Background(), "Tordek", []string{"Athletics"}); err == nil {
+		t.Errorf("expected error for class without expertise")
+	}
+}
+
+func TestJackOfAllTradesAddsHalfProficiency(t *testing.T) {
+	bard := &domain.Character{
+		Name:               "Gimble",
+		Class:              "bard",
+		Level:              2,
+		AbilityScores:      domain.AbilityScores{Str: 10, Dex: 10, Con: 10, Int: 10, Wis: 10, Cha: 16},
+		SkillProficiencies: []string{"Performance"},
+	}
+	bard.UpdateStats()
+
+	if got := bard.SkillProficiency("Athletics"); got != domain.ProficiencyHalf {
+		t.Errorf("expected half proficiency, got %s", got)
+	}
+	if got := bard.SkillBonus(domain.Skill{Name: "Athletics", Ability: "STR"}); got != 1 {
+		t.Errorf("expected Athletics +1, got %+d", got)
+	}
+	if got := bard.SkillBonus(domain.Skill{Name: "Performance", Ability: "CHA"}); got != 5 {
+		t.Errorf("expected Performance +5, got %+d", got)
+	}
+}
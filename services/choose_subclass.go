@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"starter_pack/domain"
+)
+
+type ChooseSubclassService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *ChooseSubclassService) Execute(ctx context.Context, name string, class domain.Class, subclassName string) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+
+	subclass, err := char.ChooseSubclass(class, subclassName)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("%s chose %s (%s)", char.Name, subclass.Name, subclass.Class), nil
+}
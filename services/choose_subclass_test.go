@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func TestChooseSubclassServiceSuccess(t *testing.T) {
+	char := &domain.Character{Name: "Tordek", Class: "cleric", Level: 1}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Tordek": char}}
+
+	service := &ChooseSubclassService{Repo: repo}
+	msg, err := service.Execute(context.Background(), "Tordek", "", "life domain")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.Subclass("cleric") != "Life Domain" {
+		t.Errorf("expected Life Domain, got %q", char.Subclass("cleric"))
+	}
+	if msg != "Tordek chose Life Domain (cleric)" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	sheet := &CharacterSheetService{Repo: repo}
+	output, err := sheet.Execute(context.Background(), "Tordek", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Class: cleric (Life Domain)") {
+		t.Errorf("expected subclass on sheet")
+	}
+
+	if _, err := service.Execute(context.Background(), "Tordek", "", "Life Domain"); err == nil {
+		t.Errorf("expected error when subclass already chosen")
+	}
+}
+
+func TestChooseSubclassServiceValidation(t *testing.T) {
+	char := &domain.Character{Name: "Lidda", Class: "rogue", Level: 2}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Lidda": char}}
+	service := &ChooseSubclassService{Repo: repo}
+
+	if _, err := service.Execute(context.Background(), "Lidda", "", "Thief"); err == nil {
+		t.Errorf("expected error when choosing before level 3")
+	}
+	if _, err := service.Execute(context.Background(), "Lidda", "", "Assassin"); err == nil || !strings.Contains(err.Error(), "Thief") {
+		t.Errorf("expected unknown subclass error listing options, got %v", err)
+	}
+	if _, err := service.Execute(context.Background(), "Lidda", "wizard", "School of Evocation"); err == nil {
+		t.Errorf("expected error for class without levels")
+	}
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"starter_pack/domain"
+)
+
+type ConditionService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *ConditionService) Execute(ctx context.Context, name, action, condition string) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	var changed string
+	switch strings.ToLower(action) {
+	case "add":
+		changed, err = char.AddCondition(condition)
+	case "remove":
+		changed, err = char.RemoveCondition(condition)
+	default:
+		return "", fmt.Errorf("unknown condition action %q, expected add or remove", action)
+	}
+	if err != nil {
+		return "", err
+	}
+	char.UpdateStats()
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return conditionMessage(char, action, changed), nil
+}
+
+type ExhaustionService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *ExhaustionService) Execute(ctx context.Context, name string, level int) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	changed, err := char.SetExhaustion(level)
+	if err != nil {
+		return "", err
+	}
+	char.UpdateStats()
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return conditionMessage(char, "set", changed), nil
+}
+
+func conditionMessage(c *domain.Character, action, changed string) string {
+	verb := "gains"
+	if strings.EqualFold(action, "remove") {
+		verb = "loses"
+	}
+	if strings.EqualFold(action, "set") {
+		verb = "is now at"
+	}
+	return fmt.Sprintf("%s %s %s (speed %d ft, HP %s, conditions: %s)",
+		c.Name, verb, changed, c.Speed, formatHitPoints(c), formatConditionNames(c))
+}
+
+func formatConditionNames(c *domain.Character) string {
+	var names []string
+	for _, condition := range c.ActiveConditions() {
+		names = append(names, condition.Name)
+	}
+	return joinOrNone(names)
+}
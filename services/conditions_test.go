@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func newConditionCharacter() *domain.Character {
+	return &domain.Character{
+		Name:          "Bruenor",
+		Race:          "dwarf mountain",
+		Class:         "fighter",
+		Level:         2,
+		AbilityScores: domain.AbilityScores{Str: 16, Dex: 12, Con: 14, Int: 10, Wis: 12, Cha: 8},
+	}
+}
+
+func TestConditionServiceAddAndRemove(t *testing.T) {
+	char := newConditionCharacter()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Bruenor": char}}
+	service := &ConditionService{Repo: repo}
+
+	output, err := service.Execute(context.Background(), "Bruenor", "add", "Poisoned")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "gains Poisoned") || !char.HasCondition("poisoned") {
+		t.Errorf("expected poisoned condition, got %q", output)
+	}
+	if char.PassivePerception != 6 {
+		t.Errorf("expected disadvantage to lower passive perception to 6, got %d", char.PassivePerception)
+	}
+
+	if _, err := service.Execute(context.Background(), "Bruenor", "add", "poisoned"); err == nil {
+		t.Errorf("expected error adding a condition twice")
+	}
+	if _, err := service.Execute(context.Background(), "Bruenor", "add", "posioned"); err == nil || !strings.Contains(err.Error(), "did you mean: poisoned") {
+		t.Errorf("expected suggestion for misspelled condition, got %v", err)
+	}
+
+	if _, err := service.Execute(context.Background(), "Bruenor", "add", "grappled"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.Speed != 0 {
+		t.Errorf("expected grappled speed 0, got %d", char.Speed)
+	}
+
+	if _, err := service.Execute(context.Background(), "Bruenor", "remove", "grappled"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.Speed != 25 || char.HasCondition("grappled") {
+		t.Errorf("expected speed restored to 25, got %d", char.Speed)
+	}
+	if _, err := service.Execute(context.Background(), "Bruenor", "remove", "prone"); err == nil {
+		t.Errorf("expected error removing an inactive condition")
+	}
+}
+
+func TestExhaustionServiceEffects(t *testing.T) {
+	char := newConditionCharacter()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Bruenor": char}}
+	service := &ExhaustionService{Repo: repo}
+
+	if _, err := service.Execute(context.Background(), "Bruenor", 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.Speed != 12 {
+		t.Errorf("expected halved speed 12 at exhaustion 2, got %d", char.Speed)
+	}
+	if char.MaxHP != 20 {
+		t.Errorf("expected max HP unchanged at exhaustion 2, got %d", char.MaxHP)
+	}
+
+	if _, err := service.Execute(context.Background(), "Bruenor", 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.MaxHP != 10 || char.CurrentHP != 10 {
+		t.Errorf("expected halved max HP 10/10 at exhaustion 4, got %d/%d", char.CurrentHP, char.MaxHP)
+	}
+
+	if _, err := service.Execute(context.Background(), "Bruenor", 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.MaxHP != 20 || char.CurrentHP != 10 {
+		t.Errorf("expected max HP restored without healing, got %d/%d", char.CurrentHP, char.MaxHP)
+	}
+
+	if _, err := service.Execute(context.Background(), "Bruenor", 7); err == nil {
+		t.Errorf("expected error for exhaustion above 6")
+	}
+
+	conditions := &ConditionService{Repo: repo}
+	for i := 0; i < domain.MaxExhaustion; i++ {
+		if _, err := conditions.Execute(context.Background(), "Bruenor", "add", "exhaustion"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if char.Exhaustion != domain.MaxExhaustion || char.Speed != 0 {
+		t.Errorf("expected exhaustion 6 with speed 0, got %d and %d", char.Exhaustion, char.Speed)
+	}
+	if _, err := conditions.Execute(context.Background(), "Bruenor", "add", "exhaustion"); err == nil {
+		t.Errorf("expected error beyond exhaustion 6")
+	}
+
+	sheet, err := (&CharacterSheetService{Repo: repo}).Execute(context.Background(), "Bruenor", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(sheet, "## Conditions") || !strings.Contains(sheet, "**Exhaustion 6**") || !strings.Contains(sheet, "Death") {
+		t.Errorf("expected exhaustion on sheet, got:\n%s", sheet)
+	}
+}
+
+func TestConditionSpeedIsRecomputedForUnresolvedRace(t *testing.T) {
+	char := &domain.Character{Name: "Grok", Race: "orc", Class: "fighter", Level: 2, Speed: 20,
+		Feats: []domain.CharacterFeat{{Name: "Mobile"}}, Exhaustion: 2}
+	for range 3 {
+		char.UpdateStats()
+	}
+	if char.Speed != 20 {
+		t.Errorf("expected halved speed to stay at 20, got %d", char.Speed)
+	}
+
+	legacy := &domain.Character{Name: "Thok", Race: "orc", Class: "fighter", Level: 2, Speed: 0,
+		Conditions: []string{"grappled"}}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Thok": legacy}}
+	service := &ConditionService{Repo: repo}
+
+	if _, err := service.Execute(context.Background(), "Thok", "remove", "grappled"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if legacy.Speed != 30 {
+		t.Errorf("expected speed restored after removing grappled, got %d", legacy.Speed)
+	}
+}
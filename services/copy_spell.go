@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"starter_pack/domain"
+)
+
+type CopySpellService struct {
+	Repo      domain.CharacterRepository
+	SpellRepo domain.SpellRepository
+}
+
+func (s *CopySpellService) Execute(ctx context.Context, name string, spellName string) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	spell := s.SpellRepo.FindSpellByName(spellName)
+	if spell == nil {
+		return "", fmt.Errorf("spell not found: %s", spellName)
+	}
+	if !s.SpellRepo.ClassHasSpell("wizard", spell.Name) {
+		return "", fmt.Errorf("%s is not on the wizard spell list", spell.Name)
+	}
+
+	cost, err := char.CopySpell(*spell)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("Copied %s into %s's spellbook for %d gp and %d hours (%s left)",
+		spell.Name, char.Name, cost.Gold, cost.Hours, formatCurrency(char.Currency)), nil
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func spellbookRepo() *MockSpellRepo {
+	return &MockSpellRepo{Spells: map[string]domain.Spell{
+		"Fireball":      {Name: "Fireball", Level: 3, Class: []string{"sorcerer", "wizard"}},
+		"Detect Magic":  {Name: "Detect Magic", Level: 1, Class: []string{"cleric", "wizard"}},
+		"Cure Wounds":   {Name: "Cure Wounds", Level: 1, Class: []string{"cleric"}},
+		"Fly":           {Name: "Fly", Level: 3, Class: []string{"wizard"}},
+		"Magic Missile": {Name: "Magic Missile", Level: 1, Class: []string{"wizard"}},
+	}}
+}
+
+func TestCopySpellServiceChargesGoldAndTime(t *testing.T) {
+	char := &domain.Character{Name: "Merlin", Class: "wizard", Level: 5, Currency: domain.Currency{GP: 200}}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Merlin": char}}
+	service := &CopySpellService{Repo: repo, SpellRepo: spellbookRepo()}
+	ctx := context.Background()
+
+	msg, err := service.Execute(ctx, "Merlin", "Fireball")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg != "Copied Fireball into Merlin's spellbook for 150 gp and 6 hours (50 gp left)" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+	if _, ok := char.InSpellbook("Fireball"); !ok || char.Currency.GP != 50 {
+		t.Errorf("expected Fireball in spellbook and 50 gp left, got %v and %d gp", char.Spellbook, char.Currency.GP)
+	}
+	if char.FreeSpellbookSpellsLeft() != domain.FreeSpellbookSpells(5) {
+		t.Errorf("expected copied spells not to use free spellbook spells, got %d left", char.FreeSpellbookSpellsLeft())
+	}
+
+	if _, err := service.Execute(ctx, "Merlin", "Fly"); err == nil || !strings.Contains(err.Error(), "costs 150 gp") {
+		t.Errorf("expected insufficient gold error, got %v", err)
+	}
+	if _, err := service.Execute(ctx, "Merlin", "Fireball"); err == nil || !strings.Contains(err.Error(), "already in") {
+		t.Errorf("expected duplicate copy error, got %v", err)
+	}
+	if _, err := service.Execute(ctx, "Merlin", "Cure Wounds"); err == nil || !strings.Contains(err.Error(), "not on the wizard spell list") {
+		t.Errorf("expected wizard spell list check, got %v", err)
+	}
+}
+
+func TestCopySpellServiceRequiresWizard(t *testing.T) {
+	char := &domain.Character{Name: "Jozan", Class: "cleric", Level: 5, Currency: domain.Currency{GP: 500}}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Jozan": char}}
+	service := &CopySpellService{Repo: repo, SpellRepo: spellbookRepo()}
+
+	if _, err := service.Execute(context.Background(), "Jozan", "Detect Magic"); err == nil || !strings.Contains(err.Error(), "no spellbook") {
+		t.Errorf("expected non-wizard to be refused, got %v", err)
+	}
+	if char.Currency.GP != 500 {
+		t.Errorf("expected no gold spent, got %d", char.Currency.GP)
+	}
+}
+
+func TestCopySpellServiceSaveError(t *testing.T) {
+	char := &domain.Character{Name: "Merlin", Class: "wizard", Level: 1, Currency: domain.Currency{GP: 100}}
+	repo := &MockCharacterRepo{
+		Characters: map[string]*domain.Character{"Merlin": char},
+		SaveErr:    errors.New("save failed"),
+	}
+	service := &CopySpellService{Repo: repo, SpellRepo: spellbookRepo()}
+
+	_, err := service.Execute(context.Background(), "Merlin", "Magic Missile")
+	if err == nil || err.Error() != "failed to save character: save failed" {
+		t.Errorf("expected save error, got %v", err)
+	}
+}
+
+func TestLearnSpellServiceFreeSpellbookSpells(t *testing.T) {
+	char := &domain.Character{Name: "Merlin", Class: "wizard", Level: 1}
+	for i := range domain.FreeSpellbookSpells(1) - 1 {
+		char.Spellbook = append(char.Spellbook, domain.Spell{Name: string(rune('A' + i)), Level: 1, Class: []string{"wizard"}})
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Merlin": char}}
+	service := &LearnSpellService{Repo: repo, SpellRepo: spellbookRepo()}
+	ctx := context.Background()
+
+	if _, err := service.Execute(ctx, "Merlin", "Magic Missile"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := service.Execute(ctx, "Merlin", "Detect Magic"); err == nil || !strings.Contains(err.Error(), "use copy-spell") {
+		t.Errorf("expected free spellbook spells to run out, got %v", err)
+	}
+	if len(char.Spells) != 0 {
+		t.Errorf("expected wizard spells to go into the spellbook, got %v", char.Spells)
+	}
+}
+
+func TestLearnSpellServiceMigratesLegacyWizardSpells(t *testing.T) {
+	char := &domain.Character{Name: "Merlin", Class: "wizard", Level: 1}
+	for i := range domain.FreeSpellbookSpells(1) {
+		char.Spells = append(char.Spells, domain.Spell{Name: string(rune('A' + i)), Level: 1, Class: []string{"wizard"}})
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Merlin": char}}
+	service := &LearnSpellService{Repo: repo, SpellRepo: spellbookRepo()}
+
+	if _, err := service.Execute(context.Background(), "Merlin", "Magic Missile"); err == nil || !strings.Contains(err.Error(), "use copy-spell") {
+		t.Errorf("expected a legacy wizard with a full spellbook to have no free spells left, got %v", err)
+	}
+	if len(char.Spells) != 0 || len(char.Spellbook) != domain.FreeSpellbookSpells(1) {
+		t.Errorf("expected legacy spells migrated into the spellbook, got spells %v and spellbook %v", char.Spells, char.Spellbook)
+	}
+}
+
+func TestForgetSpellServiceKeepsSpellbookSpells(t *testing.T) {
+	char := &domain.Character{Name: "Merlin", Class: "wizard", Level: 1, SpellSwapAvailable: true}
+	for i := range domain.FreeSpellbookSpells(1) {
+		char.Spellbook = append(char.Spellbook, domain.Spell{Name: string(rune('A' + i)), Level: 1, Class: []string{"wizard"}})
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Merlin": char}}
+	forget := &ForgetSpellService{Repo: repo}
+	ctx := context.Background()
+
+	if _, err := forget.Execute(ctx, "Merlin", "A"); err == nil || !strings.Contains(err.Error(), "cannot be forgotten") {
+		t.Errorf("expected removing a spellbook spell to be refused, got %v", err)
+	}
+	if len(char.Spellbook) != domain.FreeSpellbookSpells(1) || char.FreeSpellbookSpellsLeft() != 0 {
+		t.Errorf("expected the spellbook to keep all spells and no free spell to be regained, got %v", char.Spellbook)
+	}
+	learn := &LearnSpellService{Repo: repo, SpellRepo: spellbookRepo()}
+	if _, err := learn.Execute(ctx, "Merlin", "Magic Missile"); err == nil {
+		t.Errorf("expected no free spellbook spells after a refused forget")
+	}
+}
+
+func TestCastSpellServiceRitualFromSpellbook(t *testing.T) {
+	char := &domain.Character{
+		Name:  "Merlin",
+		Class: "wizard",
+		Level: 1,
+		Spellbook: []domain.Spell{
+			{Name: "Detect Magic", Level: 1, Class: []string{"wizard"}},
+			{Name: "Magic Missile", Level: 1, Class: []string{"wizard"}},
+		},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Merlin": char}}
+	service := &CastSpellService{Repo: repo}
+	ctx := context.Background()
+
+	output, err := service.Execute(ctx, "Merlin", "Detect Magic", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "Merlin casts Detect Magic as a ritual (10 extra minutes, no slot used)" {
+		t.Errorf("unexpected output %q", output)
+	}
+	if char.SpellSlotsUsed[1] != 0 {
+		t.Errorf("expected rituals not to use slots, got %v", char.SpellSlotsUsed)
+	}
+	if _, err := service.Execute(ctx, "Merlin", "Magic Missile", 0); err == nil || !strings.Contains(err.Error(), "has not prepared or learned") {
+		t.Errorf("expected unprepared non-ritual spell to be uncastable, got %v", err)
+	}
+}
+
+func TestLevelUpServiceMentionsFreeSpellbookSpells(t *testing.T) {
+	char := &domain.Character{Name: "Merlin", Class: "wizard", Level: 1, Experience: 300}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Merlin": char}}
+	service := &LevelUpService{Repo: repo}
+
+	output, err := service.Execute(context.Background(), "Merlin", domain.LevelUpOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Add 8 free spell(s) to your spellbook") {
+		t.Errorf("expected free spellbook spells in summary, got %q", output)
+	}
+}
+
+func TestCastSpellServiceRitualUsesSRDNames(t *testing.T) {
+	char := &domain.Character{
+		Name:      "Merlin",
+		Class:     "wizard",
+		Level:     5,
+		Spellbook: []domain.Spell{{Name: "Tiny Hut", Level: 3, Class: []string{"bard", "wizard"}}},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Merlin": char}}
+
+	output, err := (&CastSpellService{Repo: repo}).Execute(context.Background(), "Merlin", "Tiny Hut", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "casts Tiny Hut as a ritual") {
+		t.Errorf("unexpected output %q", output)
+	}
+}
+
+func TestCopySpellServiceSpendsOtherCoins(t *testing.T) {
+	char := &domain.Character{Name: "Merlin", Class: "wizard", Level: 5, Currency: domain.Currency{PP: 50}}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Merlin": char}}
+	service := &CopySpellService{Repo: repo, SpellRepo: spellbookRepo()}
+
+	msg, err := service.Execute(context.Background(), "Merlin", "Fireball")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.Currency != (domain.Currency{PP: 35}) || !strings.Contains(msg, "(35 pp left)") {
+		t.Errorf("expected 15 pp spent, got %+v (%s)", char.Currency, msg)
+	}
+
+	purse := domain.Currency{PP: 1, SP: 5}
+	if err := purse.SpendGP(5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if purse != (domain.Currency{GP: 5, SP: 5}) {
+		t.Errorf("expected change from a platinum piece, got %+v", purse)
+	}
+	if err := purse.SpendGP(6); err == nil {
+		t.Errorf("expected error spending more than the purse holds")
+	}
+}
@@ -4,43 +4,93 @@ import (
 	"context"
 	"fmt"
 	"starter_pack/domain"
+	"time"
 )
 
 type CreateCharacterInput struct {
-	Name       string
-	Race       domain.Race
-	Class      domain.Class
-	Background string
-	Level      int
-	Str        int
-	Dex        int
-	Con        int
-	Int        int
-	Wis        int
-	Cha        int
-	Skills     []string
+	Name              string
+	Race              domain.Race
+	Class             domain.Class
+	Background        string
+	Level             int
+	Str               int
+	Dex               int
+	Con               int
+	Int               int
+	Wis               int
+	Cha               int
+	Method            string
+	Seed              int64
+	Skills            []string
+	SkillReplacements []string
+	RandomTraits      bool
+	CustomBackground  *domain.CustomBackgroundOptions
+	RacialASI         []string
+	CustomOrigin      map[string]int
 }
 
 type CreateCharacterService struct {
-	Repo domain.CharacterRepository
+	Repo    domain.CharacterRepository
 	Factory *domain.CharacterFactory
 }
 
 func (s *CreateCharacterService) Execute(ctx context.Context, input CreateCharacterInput) (*domain.Character, error) {
+	if err := validateCreateInput(input); err != nil {
+		return nil, fmt.Errorf("invalid character: %w", err)
+	}
+
 	ab := domain.AbilityScores{
 		Str: input.Str, Dex: input.Dex, Con: input.Con,
 		Int: input.Int, Wis: input.Wis, Cha: input.Cha,
 	}
 
+	if input.Seed == 0 {
+		input.Seed = time.Now().UnixNano()
+	}
+	traitSeed := input.Seed
+	if input.Method != domain.MethodRoll {
+		input.Seed = 0
+	}
+
+	ab, err := domain.GenerateAbilityScores(input.Method, ab, input.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ability scores: %w", err)
+	}
+
+	racialASI, err := domain.ResolveRacialASI(input.Race, input.RacialASI, input.CustomOrigin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid racial ability scores: %w", err)
+	}
+
+	background, custom, err := resolveBackground(input)
+	if err != nil {
+		return nil, fmt.Errorf("invalid background: %w", err)
+	}
+
+	skills, err := selectSkills(input, background)
+	if err != nil {
+		return nil, fmt.Errorf("invalid skills: %w", err)
+	}
+
+	var profile domain.Profile
+	if input.RandomTraits {
+		profile = domain.RollPersonality(background, traitSeed)
+	}
+
 	char, err := s.Factory.Create(domain.CharacterParams{
-		ID:         domain.GenerateID(),
-		Name:       input.Name,
-		Race:       input.Race,
-		Class:      input.Class,
-		Level:      input.Level,
-		Ability:    ab,
-		Background: input.Background,
-		Skills:     input.Skills,
+		ID:               domain.GenerateID(),
+		Name:             input.Name,
+		Race:             input.Race,
+		Class:            input.Class,
+		Level:            input.Level,
+		Ability:          ab,
+		Method:           input.Method,
+		Seed:             input.Seed,
+		Background:       background.Name,
+		Skills:           skills,
+		Profile:          profile,
+		CustomBackground: custom,
+		RacialASI:        racialASI,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("cannot create character: %w", err)
@@ -52,3 +102,37 @@ func (s *CreateCharacterService) Execute(ctx context.Context, input CreateCharac
 
 	return char, nil
 }
+
+func validateCreateInput(input CreateCharacterInput) error {
+	if err := domain.ValidateRace(input.Race); err != nil {
+		return err
+	}
+	if err := domain.ValidateClass(input.Class); err != nil {
+		return err
+	}
+	if input.CustomBackground != nil {
+		return nil
+	}
+	return domain.ValidateBackground(input.Background)
+}
+
+func resolveBackground(input CreateCharacterInput) (domain.BackgroundData, *domain.BackgroundData, error) {
+	if input.CustomBackground == nil {
+		background, _ := domain.GetBackground(input.Background)
+		return background, nil, nil
+	}
+	custom, err := domain.BuildCustomBackground(*input.CustomBackground)
+	if err != nil {
+		return domain.BackgroundData{}, nil, err
+	}
+	return custom, &custom, nil
+}
+
+func selectSkills(input CreateCharacterInput, background domain.BackgroundData) ([]string, error) {
+	repo := domain.NewSkillRepository()
+	chosen := input.Skills
+	if len(chosen) == 0 && len(input.SkillReplacements) == 0 {
+		chosen = repo.DefaultClassSkills(string(input.Class), background.Skills)
+	}
+	return repo.SelectSkills(string(input.Class), background, chosen, input.SkillReplacements)
+}
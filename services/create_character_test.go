@@ -24,7 +24,7 @@ func TestCreateCharacterServiceSuccess(t *testing.T) {
 		Wis:        8,
 		Cha:        10,
 		Background: "Sage",
-		Skills:     []string{"Arcana", "History"},
+		Skills:     []string{"Investigation", "Medicine"},
 	}
 
 	result, err := service.Execute(context.Background(), input)
@@ -65,7 +65,7 @@ func TestCreateCharacterServiceRepoError(t *testing.T) {
 		Wis:        10,
 		Cha:        10,
 		Background: "Criminal",
-		Skills:     []string{"Stealth", "Acrobatics"},
+		Skills:     []string{"Acrobatics", "Athletics", "Insight", "Perception"},
 	}
 
 	_, err := service.Execute(context.Background(), input)
@@ -99,3 +99,438 @@ func TestCreateCharacterServiceInvalidInput(t *testing.T) {
 		t.Fatalf("expected error for invalid input")
 	}
 }
+
+func TestCreateCharacterServicePointBuyOverBudget(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{
+		Name:       "Greedy",
+		Race:       "Human",
+		Class:      "Fighter",
+		Background: "Soldier",
+		Level:      1,
+		Str:        15,
+		Dex:        15,
+		Con:        15,
+		Int:        10,
+		Wis:        8,
+		Cha:        8,
+		Method:     domain.MethodPointBuy,
+	}
+
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Fatalf("expected point buy budget error")
+	}
+
+	input.Str, input.Dex, input.Con, input.Int = 15, 14, 13, 12
+	input.Wis, input.Cha = 10, 8
+	if _, err := service.Execute(context.Background(), input); err != nil {
+		t.Fatalf("unexpected error for 27 point buy: %v", err)
+	}
+}
+
+func TestCreateCharacterServiceStandardArray(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{
+		Name:       "Arrayed",
+		Race:       "Gnome",
+		Class:      "Wizard",
+		Background: "Sage",
+		Level:      1,
+		Str:        8,
+		Dex:        14,
+		Con:        13,
+		Int:        15,
+		Wis:        12,
+		Cha:        10,
+		Method:     domain.MethodStandardArray,
+	}
+	if _, err := service.Execute(context.Background(), input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	input.Cha = 15
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Fatalf("expected error when standard array value is repeated")
+	}
+}
+
+func TestCreateCharacterServiceRollIsDeterministic(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{Name: "Lucky", Race: "Halfling", Class: "Rogue", Background: "Urchin",
+		Level: 1, Method: domain.MethodRoll, Seed: 42}
+	first, err := service.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	input.Name = "Lucky Again"
+	second, err := service.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if first.AbilityScores != second.AbilityScores {
+		t.Errorf("expected same scores for same seed, got %+v and %+v", first.AbilityScores, second.AbilityScores)
+	}
+	if first.AbilitySeed != 42 {
+		t.Errorf("expected seed to be recorded, got %d", first.AbilitySeed)
+	}
+
+	rolled, rolls := domain.RollAbilityScores(42)
+	rolled.Dex += domain.GetRacialBonuses("halfling")["Dex"]
+	if rolled != first.AbilityScores {
+		t.Errorf("expected rolled scores %+v, got %+v", rolled, first.AbilityScores)
+	}
+	for _, r := range rolls {
+		if r.Total < 3 || r.Total > 18 {
+			t.Errorf("roll for %s out of range: %d", r.Ability, r.Total)
+		}
+	}
+}
+
+func TestCreateCharacterServiceUnknownRaceSuggestsMatch(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{
+		Name:       "Typo",
+		Race:       "elf-high",
+		Class:      "Wizard",
+		Background: "Sage",
+		Level:      1,
+		Str:        10,
+		Dex:        10,
+		Con:        10,
+		Int:        10,
+		Wis:        10,
+		Cha:        10,
+	}
+
+	_, err := service.Execute(context.Background(), input)
+	var validationErr *domain.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	if validationErr.Field != "race" {
+		t.Errorf("expected race field, got %s", validationErr.Field)
+	}
+	if len(validationErr.Suggestions) == 0 || validationErr.Suggestions[0] != "elf high" {
+		t.Errorf("expected suggestion 'elf high', got %v", validationErr.Suggestions)
+	}
+	if len(repo.Characters) != 0 {
+		t.Errorf("expected nothing saved")
+	}
+}
+
+func TestCreateCharacterServiceSkillsCanonicalAndUnique(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{
+		Name:       "Healer",
+		Race:       "Human",
+		Class:      "Cleric",
+		Background: "Acolyte",
+		Level:      1,
+		Str:        10,
+		Dex:        10,
+		Con:        10,
+		Int:        10,
+		Wis:        10,
+		Cha:        10,
+		Skills:     []string{"medicine", "HISTORY"},
+	}
+
+	c, err := service.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"Medicine", "History", "Insight", "Religion"}
+	if len(c.SkillProficiencies) != len(expected) {
+		t.Fatalf("expected skills %v, got %v", expected, c.SkillProficiencies)
+	}
+	for i, skill := range expected {
+		if c.SkillProficiencies[i] != skill {
+			t.Errorf("expected skills %v, got %v", expected, c.SkillProficiencies)
+			break
+		}
+	}
+}
+
+func TestCreateCharacterServiceSkillValidation(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	base := CreateCharacterInput{
+		Name:       "Healer",
+		Race:       "Human",
+		Class:      "Cleric",
+		Background: "Acolyte",
+		Level:      1,
+		Str:        10,
+		Dex:        10,
+		Con:        10,
+		Int:        10,
+		Wis:        10,
+		Cha:        10,
+	}
+
+	cases := map[string][]string{
+		"not a class skill": {"Medicine", "Stealth"},
+		"too few":           {"Medicine"},
+		"too many":          {"Medicine", "History", "Persuasion"},
+		"duplicate":         {"Medicine", "medicine"},
+		"unknown":           {"Medicine", "Histroy"},
+	}
+	for name, skills := range cases {
+		input := base
+		input.Skills = skills
+		if _, err := service.Execute(context.Background(), input); err == nil {
+			t.Errorf("%s: expected error for skills %v", name, skills)
+		}
+	}
+	if len(repo.Characters) != 0 {
+		t.Errorf("expected nothing saved")
+	}
+}
+
+func TestCreateCharacterServiceBackgroundOverlapNeedsReplacement(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{
+		Name:       "Healer",
+		Race:       "Human",
+		Class:      "Cleric",
+		Background: "Acolyte",
+		Level:      1,
+		Str:        10,
+		Dex:        10,
+		Con:        10,
+		Int:        10,
+		Wis:        10,
+		Cha:        10,
+		Skills:     []string{"Insight", "Medicine"},
+	}
+
+	_, err := service.Execute(context.Background(), input)
+	var overlap *domain.SkillOverlapError
+	if !errors.As(err, &overlap) {
+		t.Fatalf("expected overlap error, got %v", err)
+	}
+	if len(overlap.Skills) != 1 || overlap.Skills[0] != "Insight" {
+		t.Errorf("expected Insight overlap, got %v", overlap.Skills)
+	}
+
+	input.SkillReplacements = []string{"religion"}
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Fatalf("expected error for replacement already granted by background")
+	}
+
+	input.SkillReplacements = []string{"stealth"}
+	c, err := service.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	insight := 0
+	for _, skill := range c.SkillProficiencies {
+		if skill == "Insight" {
+			insight++
+		}
+	}
+	if insight != 1 {
+		t.Errorf("expected Insight once, got %v", c.SkillProficiencies)
+	}
+	if !domain.NewSkillRepository().HasSkill(c.SkillProficiencies, "Stealth") {
+		t.Errorf("expected replacement Stealth, got %v", c.SkillProficiencies)
+	}
+}
+
+func TestCreateCharacterServiceBackgroundData(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{
+		Name:         "Healer",
+		Race:         "Human",
+		Class:        "Cleric",
+		Background:   "acolyte",
+		Level:        1,
+		Str:          10,
+		Dex:          10,
+		Con:          10,
+		Int:          10,
+		Wis:          10,
+		Cha:          10,
+		Seed:         7,
+		RandomTraits: true,
+	}
+
+	c, err := service.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Background != "Acolyte" {
+		t.Errorf("expected Acolyte background, got %s", c.Background)
+	}
+	if c.Currency.GP != 15 || len(c.Inventory) == 0 {
+		t.Errorf("expected starting equipment and 15 gp, got %v and %+v", c.Inventory, c.Currency)
+	}
+	if c.LanguageChoices() != 3 {
+		t.Errorf("expected 3 language choices, got %d", c.LanguageChoices())
+	}
+
+	acolyte, _ := domain.GetBackground("acolyte")
+	expected := domain.RollPersonality(acolyte, 7)
+	if len(c.Profile.PersonalityTraits) != 2 || c.Profile.PersonalityTraits[0] != expected.PersonalityTraits[0] {
+		t.Errorf("expected seeded traits %v, got %v", expected.PersonalityTraits, c.Profile.PersonalityTraits)
+	}
+	if c.Profile.Ideal == "" || c.Profile.Bond == "" || c.Profile.Flaw == "" {
+		t.Errorf("expected ideal, bond and flaw, got %+v", c.Profile)
+	}
+	if c.Profile.TraitSeed != 7 {
+		t.Errorf("expected trait seed to be recorded, got %d", c.Profile.TraitSeed)
+	}
+}
+
+func TestCreateCharacterServiceCustomBackground(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{
+		Name:       "Hunter",
+		Race:       "Human",
+		Class:      "Ranger",
+		Background: "Bounty Hunter",
+		Level:      1,
+		Str:        10,
+		Dex:        10,
+		Con:        10,
+		Int:        10,
+		Wis:        10,
+		Cha:        10,
+		CustomBackground: &domain.CustomBackgroundOptions{
+			Name:      "Bounty Hunter",
+			Feature:   "criminal",
+			Skills:    []string{"investigation", "stealth"},
+			Tools:     []string{"thieves' tools"},
+			Languages: 1,
+		},
+	}
+
+	c, err := service.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	background, _ := c.BackgroundData()
+	if background.Name != "Bounty Hunter" || background.Feature.Name != "Criminal Contact" {
+		t.Errorf("unexpected custom background %+v", background)
+	}
+	repoSkills := domain.NewSkillRepository()
+	if !repoSkills.HasSkill(c.SkillProficiencies, "Investigation") || !repoSkills.HasSkill(c.SkillProficiencies, "Stealth") {
+		t.Errorf("expected custom background skills, got %v", c.SkillProficiencies)
+	}
+
+	input.Name = "Greedy"
+	input.CustomBackground.Languages = 2
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Errorf("expected error for more than two tools or languages")
+	}
+	input.CustomBackground.Languages = 1
+	input.CustomBackground.Feature = "pirate"
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Errorf("expected error for unknown feature background")
+	}
+}
+
+func TestCreateCharacterServiceRacialASIChoices(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{
+		Name:       "Tanis",
+		Race:       "half-elf",
+		Class:      "Ranger",
+		Background: "Outlander",
+		Level:      1,
+		Str:        10,
+		Dex:        14,
+		Con:        12,
+		Int:        10,
+		Wis:        13,
+		Cha:        8,
+		RacialASI:  []string{"dex", "wis"},
+	}
+
+	c, err := service.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.AbilityScores.Dex != 15 || c.AbilityScores.Wis != 14 || c.AbilityScores.Cha != 10 {
+		t.Errorf("expected +1 DEX +1 WIS +2 CHA, got %+v", c.AbilityScores)
+	}
+
+	input.Name = "Missing"
+	input.RacialASI = nil
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Errorf("expected error when half-elf choices are missing")
+	}
+
+	input.RacialASI = []string{"cha", "dex"}
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Errorf("expected error when half-elf chooses CHA")
+	}
+
+	input.RacialASI = []string{"dex", "dex"}
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Errorf("expected error for duplicate choices")
+	}
+
+	input.Race = "dwarf hill"
+	input.RacialASI = []string{"dex", "wis"}
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Errorf("expected error for choices on a race without any")
+	}
+}
+
+func TestCreateCharacterServiceCustomOrigin(t *testing.T) {
+	repo := &MockCharacterRepo{Characters: make(map[string]*domain.Character)}
+	service := &CreateCharacterService{Repo: repo, Factory: &domain.CharacterFactory{}}
+
+	input := CreateCharacterInput{
+		Name:         "Bruenor",
+		Race:         "dwarf mountain",
+		Class:        "Wizard",
+		Background:   "Sage",
+		Level:        1,
+		Str:          8,
+		Dex:          12,
+		Con:          13,
+		Int:          15,
+		Wis:          10,
+		Cha:          10,
+		CustomOrigin: map[string]int{"int": 2, "con": 2},
+	}
+
+	c, err := service.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.AbilityScores.Int != 17 || c.AbilityScores.Con != 15 || c.AbilityScores.Str != 8 {
+		t.Errorf("expected racial increases moved to INT and CON, got %+v", c.AbilityScores)
+	}
+	if c.RacialBonuses()["INT"] != 2 {
+		t.Errorf("expected stored custom origin, got %v", c.RacialASI)
+	}
+
+	input.Name = "Cheater"
+	input.CustomOrigin = map[string]int{"int": 2, "con": 2, "wis": 1}
+	if _, err := service.Execute(context.Background(), input); err == nil {
+		t.Errorf("expected error when custom origin adds increases")
+	}
+}
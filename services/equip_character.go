@@ -20,6 +20,7 @@ func (s *EquipItemService) Execute(ctx context.Context, name, itemType, itemName
 	itemType = strings.ToLower(itemType)
 	itemName = strings.ToLower(itemName)
 	slot = strings.ToLower(slot)
+	warning := ""
 
 	switch itemType {
 	case "weapon":
@@ -29,14 +30,24 @@ func (s *EquipItemService) Execute(ctx context.Context, name, itemType, itemName
 		if err := char.EquipWeapon(itemName, slot); err != nil {
 			return "", err
 		}
+		if !char.IsProficientWithWeapon(itemName) {
+			warning = fmt.Sprintf("%s is not proficient with %s: no proficiency bonus to attack rolls", char.Name, itemName)
+		}
 	case "armor":
 		if err := char.EquipArmor(itemName); err != nil {
 			return "", err
 		}
+		if !char.IsProficientWithArmor(itemName) {
+			warning = fmt.Sprintf("%s is not proficient with %s: disadvantage on STR and DEX checks, saves and attacks, and no spellcasting",
+				char.Name, domain.ArmorCategory(itemName))
+		}
 	case "shield":
 		if err := char.EquipShield(itemName); err != nil {
 			return "", err
 		}
+		if !char.IsProficientWithShield() {
+			warning = fmt.Sprintf("%s is not proficient with shields: disadvantage on STR and DEX checks, saves and attacks, and no spellcasting", char.Name)
+		}
 	default:
 		return "", fmt.Errorf("unknown item type: %s", itemType)
 	}
@@ -45,5 +56,8 @@ func (s *EquipItemService) Execute(ctx context.Context, name, itemType, itemName
 		return "", fmt.Errorf("failed to save character: %w", err)
 	}
 
+	if warning != "" {
+		return fmt.Sprintf("Equipped %s (warning: %s)", itemName, warning), nil
+	}
 	return fmt.Sprintf("Equipped %s", itemName), nil
 }
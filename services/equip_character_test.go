@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"starter_pack/domain"
 )
@@ -82,3 +83,55 @@ func TestEquipItemService(t *testing.T) {
 		t.Fatalf("expected error when item type is unknown")
 	}
 }
+
+func TestEquipItemServiceWarnsWithoutProficiency(t *testing.T) {
+	repo := newMockRepo()
+	wizard := &domain.Character{Name: "Mialee", Class: "wizard", Level: 1}
+	fighter := &domain.Character{Name: "Tordek", Class: "fighter", Level: 1}
+	repo.Save(context.Background(), wizard)
+	repo.Save(context.Background(), fighter)
+
+	service := &EquipItemService{Repo: repo}
+
+	msg, err := service.Execute(context.Background(), "Mialee", "armor", "Chain Mail", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(msg, "not proficient with heavy armor") {
+		t.Errorf("expected heavy armor warning, got %q", msg)
+	}
+	if wizard.Equipment.Armor == nil {
+		t.Errorf("expected armor to be equipped despite the warning")
+	}
+
+	msg, err = service.Execute(context.Background(), "Mialee", "weapon", "Longsword", "main hand")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(msg, "not proficient with longsword") {
+		t.Errorf("expected weapon warning, got %q", msg)
+	}
+
+	msg, err = service.Execute(context.Background(), "Tordek", "armor", "Chain Mail", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg != "Equipped chain mail" {
+		t.Errorf("expected no warning for fighter, got %q", msg)
+	}
+}
+
+func TestEquipItemServiceSubclassArmorProficiency(t *testing.T) {
+	repo := newMockRepo()
+	cleric := &domain.Character{Name: "Jozan", Classes: []domain.ClassLevel{{Class: "cleric", Level: 1, Subclass: "Life Domain"}}}
+	repo.Save(context.Background(), cleric)
+
+	service := &EquipItemService{Repo: repo}
+	msg, err := service.Execute(context.Background(), "Jozan", "armor", "Plate", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg != "Equipped plate" {
+		t.Errorf("expected Life Domain heavy armor proficiency, got %q", msg)
+	}
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func TestLevelUpServiceFeatInsteadOfASI(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Gimli",
+		Class:         "fighter",
+		Level:         3,
+		AbilityScores: domain.AbilityScores{Str: 16, Dex: 12, Con: 15, Int: 10, Wis: 10, Cha: 8},
+	}
+	char.UpdateStats()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Gimli": char}}
+	service := &LevelUpService{Repo: repo}
+
+	initiative := char.Initiative
+	msg, err := service.Execute(context.Background(), "Gimli", domain.LevelUpOptions{Feat: "Alert"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !char.HasFeat("Alert") || char.Initiative != initiative+5 {
+		t.Errorf("expected Alert to add +5 initiative, got %d", char.Initiative)
+	}
+	if !strings.Contains(msg, "Feat: Alert") {
+		t.Errorf("unexpected summary: %s", msg)
+	}
+
+	char.Classes[0].Level = 5
+	char.UpdateStats()
+	maxHP := char.MaxHP
+	if _, err := service.Execute(context.Background(), "Gimli", domain.LevelUpOptions{Feat: "Tough"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.MaxHP != maxHP+8+2*char.Level {
+		t.Errorf("expected Tough to add 2 HP per level, got %d from %d", char.MaxHP, maxHP)
+	}
+
+	sheet := &CharacterSheetService{Repo: repo}
+	output, err := sheet.Execute(context.Background(), "Gimli", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "## Feats") || !strings.Contains(output, "**Tough**") {
+		t.Errorf("expected feats on sheet, got:\n%s", output)
+	}
+}
+
+func TestLevelUpServiceResilientAddsSave(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Mialee",
+		Class:         "wizard",
+		Level:         3,
+		AbilityScores: domain.AbilityScores{Str: 8, Dex: 14, Con: 13, Int: 16, Wis: 12, Cha: 10},
+	}
+	char.UpdateStats()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Mialee": char}}
+	service := &LevelUpService{Repo: repo}
+
+	if _, err := service.Execute(context.Background(), "Mialee", domain.LevelUpOptions{Feat: "Resilient"}); err == nil {
+		t.Fatalf("expected error when Resilient has no ability choice")
+	}
+	if _, err := service.Execute(context.Background(), "Mialee", domain.LevelUpOptions{Feat: "Resilient", FeatAbility: "con"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.AbilityScores.Con != 14 {
+		t.Errorf("expected CON 14, got %d", char.AbilityScores.Con)
+	}
+	for _, save := range char.SavingThrows() {
+		if save.Ability == "CON" && (!save.Proficient || save.Bonus != 4) {
+			t.Errorf("expected proficient CON save +4, got %+v", save)
+		}
+	}
+}
+
+func TestLevelUpServiceFeatPrerequisites(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Pippin",
+		Class:         "rogue",
+		Level:         3,
+		AbilityScores: domain.AbilityScores{Str: 10, Dex: 16, Con: 12, Int: 10, Wis: 10, Cha: 10},
+	}
+	char.UpdateStats()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Pippin": char}}
+	service := &LevelUpService{Repo: repo}
+
+	cases := map[string]domain.LevelUpOptions{
+		"STR prerequisite":          {Feat: "Grappler"},
+		"spellcasting prerequisite": {Feat: "War Caster"},
+		"armor prerequisite":        {Feat: "Heavily Armored"},
+		"unknown feat":              {Feat: "Alertt"},
+		"feat and ASI":              {Feat: "Alert", ASI: []string{"DEX"}},
+	}
+	for name, opts := range cases {
+		if _, err := service.Execute(context.Background(), "Pippin", opts); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+	if char.Level != 3 {
+		t.Fatalf("expected no level gained, got %d", char.Level)
+	}
+
+	if _, err := service.Execute(context.Background(), "Pippin", domain.LevelUpOptions{Feat: "Observant", FeatAbility: "WIS"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := char.PassiveScore("Investigation"); got != 15 {
+		t.Errorf("expected passive Investigation 15 with Observant, got %d", got)
+	}
+}
+
+func TestMobileSpeedBonusIsNotCumulative(t *testing.T) {
+	char := &domain.Character{
+		Name:  "Grok",
+		Race:  "orc",
+		Class: "fighter",
+		Level: 4,
+		Speed: 30,
+		Feats: []domain.CharacterFeat{{Name: "Mobile"}},
+	}
+	for range 3 {
+		char.UpdateStats()
+	}
+	if char.Speed != 40 {
+		t.Errorf("expected Mobile to add 10 ft once for an unresolved race, got %d", char.Speed)
+	}
+
+	elf := &domain.Character{Name: "Sylva", Race: "wood elf", Class: "ranger", Level: 4,
+		Feats: []domain.CharacterFeat{{Name: "Mobile"}}}
+	elf.UpdateStats()
+	elf.UpdateStats()
+	if elf.Speed != 45 {
+		t.Errorf("expected wood elf speed 35 + 10, got %d", elf.Speed)
+	}
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"starter_pack/domain"
+)
+
+type DamageService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *DamageService) Execute(ctx context.Context, name string, amount int) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	absorbed, err := char.TakeDamage(amount)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	msg := fmt.Sprintf("%s takes %d damage", char.Name, amount)
+	if absorbed > 0 {
+		msg += fmt.Sprintf(" (%d absorbed by temporary HP)", absorbed)
+	}
+	return msg + fmt.Sprintf(", HP %s", formatHitPoints(char)), nil
+}
+
+type HealService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *HealService) Execute(ctx context.Context, name string, amount int) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	if err := char.Heal(amount); err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("%s heals %d, HP %s", char.Name, amount, formatHitPoints(char)), nil
+}
+
+type TempHPService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *TempHPService) Execute(ctx context.Context, name string, amount int) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	if err := char.GrantTempHP(amount); err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("%s has %d temporary HP, HP %s", char.Name, char.TempHP, formatHitPoints(char)), nil
+}
+
+func formatHitPoints(c *domain.Character) string {
+	hp := fmt.Sprintf("%d/%d", c.CurrentHP, c.MaxHP)
+	if c.TempHP > 0 {
+		hp += fmt.Sprintf(" (+%d temp)", c.TempHP)
+	}
+	return hp
+}
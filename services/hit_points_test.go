@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func newHitPointsCharacter() *domain.Character {
+	char := &domain.Character{
+		Name:          "Conan",
+		Class:         "Barbarian",
+		Level:         3,
+		AbilityScores: domain.AbilityScores{Str: 16, Dex: 12, Con: 14, Int: 8, Wis: 10, Cha: 10},
+	}
+	char.UpdateStats()
+	return char
+}
+
+func TestCharacterMaxHP(t *testing.T) {
+	char := newHitPointsCharacter()
+	if char.MaxHP != 32 || char.CurrentHP != 32 {
+		t.Fatalf("expected 32/32 HP, got %d/%d", char.CurrentHP, char.MaxHP)
+	}
+
+	char.AbilityScores.Con = 16
+	char.UpdateStats()
+	if char.MaxHP != 35 || char.CurrentHP != 35 {
+		t.Errorf("expected CON increase to raise HP to 35/35, got %d/%d", char.CurrentHP, char.MaxHP)
+	}
+	if got := domain.FormatHitDice(char.HitDiceRemaining()); got != "3d12" {
+		t.Errorf("expected 3d12 hit dice, got %s", got)
+	}
+}
+
+func TestDamageServiceUsesTempHPFirst(t *testing.T) {
+	char := newHitPointsCharacter()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Conan": char}}
+
+	tempService := &TempHPService{Repo: repo}
+	if _, err := tempService.Execute(context.Background(), "Conan", 5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	service := &DamageService{Repo: repo}
+	msg, err := service.Execute(context.Background(), "Conan", 8)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.TempHP != 0 || char.CurrentHP != 29 {
+		t.Errorf("expected 29 HP and no temp HP, got %d (+%d)", char.CurrentHP, char.TempHP)
+	}
+	if !strings.Contains(msg, "5 absorbed") {
+		t.Errorf("expected absorbed damage in message, got %s", msg)
+	}
+
+	if _, err := service.Execute(context.Background(), "Conan", 100); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.CurrentHP != 0 {
+		t.Errorf("expected HP to stop at 0, got %d", char.CurrentHP)
+	}
+}
+
+func TestHealServiceCapsAtMax(t *testing.T) {
+	char := newHitPointsCharacter()
+	char.CurrentHP = 10
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Conan": char}}
+
+	service := &HealService{Repo: repo}
+	if _, err := service.Execute(context.Background(), "Conan", 50); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.CurrentHP != char.MaxHP {
+		t.Errorf("expected HP capped at %d, got %d", char.MaxHP, char.CurrentHP)
+	}
+
+	if _, err := service.Execute(context.Background(), "Conan", -3); err == nil {
+		t.Errorf("expected error for negative healing")
+	}
+}
+
+func TestTempHPServiceDoesNotStack(t *testing.T) {
+	char := newHitPointsCharacter()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Conan": char}}
+	service := &TempHPService{Repo: repo}
+
+	service.Execute(context.Background(), "Conan", 8)
+	service.Execute(context.Background(), "Conan", 4)
+	if char.TempHP != 8 {
+		t.Errorf("expected higher temp HP to be kept, got %d", char.TempHP)
+	}
+}
+
+func TestDamageServiceSaveError(t *testing.T) {
+	char := newHitPointsCharacter()
+	repo := &MockCharacterRepo{
+		Characters: map[string]*domain.Character{"Conan": char},
+		SaveErr:    errors.New("save failed"),
+	}
+	service := &DamageService{Repo: repo}
+	_, err := service.Execute(context.Background(), "Conan", 3)
+	if err == nil || err.Error() != "failed to save character: save failed" {
+		t.Errorf("expected save error, got %v", err)
+	}
+}
@@ -17,13 +17,16 @@ func (s *LearnSpellService) Execute(ctx context.Context, name string, spellName
 	if err != nil {
 		return "", fmt.Errorf("character not found: %w", err)
 	}
+	char.UpdateStats()
 
 	spell := s.SpellRepo.FindSpellByName(spellName)
 	if spell == nil {
 		return "", fmt.Errorf("spell not found: %s", spellName)
 	}
 
-	char.LearnSpell(*spell)
+	if err := char.LearnSpell(*spell); err != nil {
+		return "", err
+	}
 
 	if err := s.Repo.Save(ctx, char); err != nil {
 		return "", fmt.Errorf("failed to save character: %w", err)
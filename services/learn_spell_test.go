@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"starter_pack/domain"
+	"strings"
 	"testing"
 )
 
@@ -10,7 +11,7 @@ func TestLearnSpellServiceSuccess(t *testing.T) {
 	char := &domain.Character{
 		Name:   "Gandalf",
 		Class:  "Wizard",
-		Level:  3,
+		Level:  5,
 		Spells: []domain.Spell{},
 	}
 	repo := &MockCharacterRepo{
@@ -28,8 +29,8 @@ func TestLearnSpellServiceSuccess(t *testing.T) {
 	if msg != "Learned spell Fireball" {
 		t.Errorf("unexpected message: %s", msg)
 	}
-	if len(char.Spells) != 1 || char.Spells[0].Name != "Fireball" {
-		t.Errorf("spell not added to character")
+	if len(char.Spellbook) != 1 || char.Spellbook[0].Name != "Fireball" {
+		t.Errorf("spell not added to the spellbook")
 	}
 }
 
@@ -55,3 +56,148 @@ func TestLearnSpellServiceSpellNotFound(t *testing.T) {
 		t.Errorf("expected spell not found error, got %v", err)
 	}
 }
+
+func TestLearnSpellServiceRejectsDuplicates(t *testing.T) {
+	char := &domain.Character{Name: "Gandalf", Class: "wizard", Level: 5}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Gandalf": char}}
+	spellRepo := &MockSpellRepo{
+		Spells: map[string]domain.Spell{"Fireball": {Name: "Fireball", Level: 3, Class: []string{"wizard"}}},
+	}
+
+	service := &LearnSpellService{Repo: repo, SpellRepo: spellRepo}
+	if _, err := service.Execute(context.Background(), "Gandalf", "Fireball"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := service.Execute(context.Background(), "Gandalf", "Fireball"); err == nil || !strings.Contains(err.Error(), "already known") {
+		t.Errorf("expected duplicate error, got %v", err)
+	}
+}
+
+func TestLearnSpellServiceEnforcesLimits(t *testing.T) {
+	char := &domain.Character{Name: "Sorra", Class: "sorcerer", Level: 1}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Sorra": char}}
+	spellRepo := &MockSpellRepo{Spells: map[string]domain.Spell{
+		"Shield":         {Name: "Shield", Level: 1, Class: []string{"sorcerer", "wizard"}},
+		"Sleep":          {Name: "Sleep", Level: 1, Class: []string{"sorcerer", "wizard"}},
+		"Magic Missile":  {Name: "Magic Missile", Level: 1, Class: []string{"sorcerer", "wizard"}},
+		"Misty Step":     {Name: "Misty Step", Level: 2, Class: []string{"sorcerer", "wizard"}},
+		"Cure Wounds":    {Name: "Cure Wounds", Level: 1, Class: []string{"cleric", "bard"}},
+		"Fire Bolt":      {Name: "Fire Bolt", Level: 0, Class: []string{"sorcerer", "wizard"}},
+		"Light":          {Name: "Light", Level: 0, Class: []string{"sorcerer", "wizard"}},
+		"Mage Hand":      {Name: "Mage Hand", Level: 0, Class: []string{"sorcerer", "wizard"}},
+		"Ray of Frost":   {Name: "Ray of Frost", Level: 0, Class: []string{"sorcerer", "wizard"}},
+		"Shocking Grasp": {Name: "Shocking Grasp", Level: 0, Class: []string{"sorcerer", "wizard"}},
+	}}
+	service := &LearnSpellService{Repo: repo, SpellRepo: spellRepo}
+
+	for _, spell := range []string{"Shield", "Sleep", "Fire Bolt", "Light", "Mage Hand", "Ray of Frost"} {
+		if _, err := service.Execute(context.Background(), "Sorra", spell); err != nil {
+			t.Fatalf("unexpected error learning %s: %v", spell, err)
+		}
+	}
+	cases := map[string]string{
+		"Magic Missile":  "knows at most 2 spells",
+		"Shocking Grasp": "knows at most 4 cantrips",
+		"Misty Step":     "can only learn spells up to level 1",
+		"Cure Wounds":    "not on the sorcerer spell list",
+	}
+	for spell, want := range cases {
+		if _, err := service.Execute(context.Background(), "Sorra", spell); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q learning %s, got %v", want, spell, err)
+		}
+	}
+}
+
+func TestSwapSpellServiceAfterLevelUp(t *testing.T) {
+	char := &domain.Character{
+		Name:   "Sorra",
+		Class:  "sorcerer",
+		Level:  1,
+		Spells: []domain.Spell{{Name: "Shield", Level: 1, Class: []string{"sorcerer"}}, {Name: "Sleep", Level: 1, Class: []string{"sorcerer"}}},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Sorra": char}}
+	spellRepo := &MockSpellRepo{Spells: map[string]domain.Spell{
+		"Magic Missile": {Name: "Magic Missile", Level: 1, Class: []string{"sorcerer"}},
+	}}
+	service := &SwapSpellService{Repo: repo, SpellRepo: spellRepo}
+
+	if _, err := service.Execute(context.Background(), "Sorra", "Sleep", "Magic Missile"); err == nil {
+		t.Errorf("expected swap to require a level-up")
+	}
+
+	if _, err := (&LevelUpService{Repo: repo}).Execute(context.Background(), "Sorra", domain.LevelUpOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	msg, err := service.Execute(context.Background(), "Sorra", "Sleep", "Magic Missile")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg != "Replaced spell Sleep with Magic Missile" || len(char.Spells) != 2 || char.Spells[1].Name != "Magic Missile" {
+		t.Errorf("unexpected swap result %q, spells %v", msg, char.Spells)
+	}
+	if _, err := service.Execute(context.Background(), "Sorra", "Shield", "Sleep"); err == nil {
+		t.Errorf("expected only one swap per level")
+	}
+
+	forget := &ForgetSpellService{Repo: repo}
+	if _, err := forget.Execute(context.Background(), "Sorra", "shield"); err == nil || len(char.Spells) != 2 {
+		t.Errorf("expected forgetting to require an unused swap, got %v (%v)", char.Spells, err)
+	}
+}
+
+func TestForgetSpellServiceConsumesSwap(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Sorra",
+		Class:         "sorcerer",
+		Level:         1,
+		AbilityScores: domain.AbilityScores{Str: 13, Cha: 15},
+		Spells:        []domain.Spell{{Name: "Shield", Level: 1, Class: []string{"sorcerer"}}, {Name: "Sleep", Level: 1, Class: []string{"sorcerer"}}},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Sorra": char}}
+	forget := &ForgetSpellService{Repo: repo}
+	levelUp := &LevelUpService{Repo: repo}
+	ctx := context.Background()
+
+	if _, err := forget.Execute(ctx, "Sorra", "Sleep"); err == nil || !strings.Contains(err.Error(), "use swap-spell") {
+		t.Errorf("expected forgetting at the known limit to be refused, got %v", err)
+	}
+
+	if _, err := levelUp.Execute(ctx, "Sorra", domain.LevelUpOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := forget.Execute(ctx, "Sorra", "Sleep"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.SpellSwapAvailable || len(char.Spells) != 1 {
+		t.Errorf("expected forgetting to consume the swap, got %v and %v", char.SpellSwapAvailable, char.Spells)
+	}
+
+	if _, err := levelUp.Execute(ctx, "Sorra", domain.LevelUpOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := levelUp.Execute(ctx, "Sorra", domain.LevelUpOptions{Class: "fighter"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.SpellSwapAvailable {
+		t.Errorf("expected an unused swap to expire on the next level up")
+	}
+}
+
+func TestForgetSpellServiceRefusesCantrips(t *testing.T) {
+	char := &domain.Character{
+		Name:               "Elara",
+		Class:              "wizard",
+		Level:              2,
+		SpellSwapAvailable: true,
+		Spells:             []domain.Spell{{Name: "Fire Bolt", Level: 0, Class: []string{"wizard"}}},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Elara": char}}
+	forget := &ForgetSpellService{Repo: repo}
+
+	if _, err := forget.Execute(context.Background(), "Elara", "Fire Bolt"); err == nil || !strings.Contains(err.Error(), "cantrips cannot be forgotten") {
+		t.Errorf("expected forgetting a cantrip to be refused, got %v", err)
+	}
+	if len(char.Spells) != 1 || !char.SpellSwapAvailable {
+		t.Errorf("expected the cantrip and the swap to be kept, got %v and %v", char.Spells, char.SpellSwapAvailable)
+	}
+}
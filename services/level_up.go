@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"starter_pack/domain"
+)
+
+type LevelUpService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *LevelUpService) Execute(ctx context.Context, name string, opts domain.LevelUpOptions) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	if strings.ToLower(opts.HPMethod) == domain.HPMethodRoll && opts.Seed == 0 {
+		opts.Seed = time.Now().UnixNano()
+	}
+
+	summary, err := char.LevelUp(opts)
+	if err != nil {
+		return "", fmt.Errorf("cannot level up: %w", err)
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return formatLevelUpSummary(char, summary), nil
+}
+
+func formatLevelUpSummary(c *domain.Character, summary *domain.LevelUpSummary) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s is now level %d (was %d), %s %d\n",
+		c.Name, summary.NewLevel, summary.OldLevel, summary.Class, summary.ClassLevel))
+
+	if summary.HPMethod == domain.HPMethodRoll {
+		sb.WriteString(fmt.Sprintf("Hit points: +%d (rolled %d), max HP %d\n", summary.HPGained, summary.HPRoll, c.MaxHP))
+	} else {
+		sb.WriteString(fmt.Sprintf("Hit points: +%d (average), max HP %d\n", summary.HPGained, c.MaxHP))
+	}
+
+	if summary.NewProficiencyBonus != summary.OldProficiencyBonus {
+		sb.WriteString(fmt.Sprintf("Proficiency bonus: +%d -> +%d\n", summary.OldProficiencyBonus, summary.NewProficiencyBonus))
+	}
+
+	for _, ability := range domain.AbilityNames {
+		if inc, ok := summary.AbilityIncreases[ability]; ok {
+			sb.WriteString(fmt.Sprintf("%s: +%d (now %d)\n", ability, inc, c.AbilityScores.Get(ability)))
+		}
+	}
+	if summary.Feat != nil {
+		sb.WriteString(fmt.Sprintf("Feat: %s\n", formatFeat(*summary.Feat)))
+	}
+
+	levels := make([]int, 0, len(summary.NewSpellSlots))
+	for lvl := range summary.NewSpellSlots {
+		levels = append(levels, lvl)
+	}
+	sort.Ints(levels)
+	for _, lvl := range levels {
+		if lvl == 0 {
+			continue
+		}
+		if oldCount, newCount := summary.OldSpellSlots[lvl], summary.NewSpellSlots[lvl]; newCount != oldCount {
+			sb.WriteString(fmt.Sprintf("Level %d spell slots: %d -> %d\n", lvl, oldCount, newCount))
+		}
+	}
+
+	if c.NeedsSubclass(summary.Class) {
+		sb.WriteString(fmt.Sprintf("Choose a %s subclass with choose-subclass: %s\n",
+			summary.Class, strings.Join(domain.SubclassNames(summary.Class), ", ")))
+	}
+	if c.SpellSwapAvailable && domain.KnowsSpells(summary.Class) {
+		sb.WriteString("You may replace one known spell with the swap-spell command\n")
+	}
+	if free := c.FreeSpellbookSpellsLeft(); free > 0 && strings.EqualFold(string(summary.Class), "wizard") {
+		sb.WriteString(fmt.Sprintf("Add %d free spell(s) to your spellbook with learn-spell\n", free))
+	}
+	if pending := c.ExpertiseSlots() - len(c.SkillExpertise); pending > 0 {
+		sb.WriteString(fmt.Sprintf("Choose %d expertise skill(s) with the expertise command\n", pending))
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
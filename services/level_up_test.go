@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func TestLevelUpServiceAverageHP(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Merry",
+		Class:         "Cleric",
+		Level:         4,
+		AbilityScores: domain.AbilityScores{Str: 10, Dex: 10, Con: 14, Int: 10, Wis: 16, Cha: 10},
+	}
+	char.UpdateStats()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Merry": char}}
+
+	service := &LevelUpService{Repo: repo}
+	msg, err := service.Execute(context.Background(), "Merry", domain.LevelUpOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if char.Level != 5 {
+		t.Errorf("expected level 5, got %d", char.Level)
+	}
+	if char.ProficiencyBonus != 3 {
+		t.Errorf("expected proficiency bonus 3, got %d", char.ProficiencyBonus)
+	}
+	if char.MaxHP != 38 {
+		t.Errorf("expected max HP 38, got %d", char.MaxHP)
+	}
+	if char.SpellSlots[3] != 2 {
+		t.Errorf("expected two 3rd level slots, got %d", char.SpellSlots[3])
+	}
+	if !strings.Contains(msg, "Proficiency bonus: +2 -> +3") || !strings.Contains(msg, "Level 3 spell slots: 0 -> 2") {
+		t.Errorf("unexpected summary: %s", msg)
+	}
+}
+
+func TestLevelUpServiceRequiresASI(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Pippin",
+		Class:         "Rogue",
+		Level:         3,
+		AbilityScores: domain.AbilityScores{Str: 10, Dex: 19, Con: 12, Int: 10, Wis: 10, Cha: 10},
+	}
+	char.UpdateStats()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Pippin": char}}
+	service := &LevelUpService{Repo: repo}
+
+	if _, err := service.Execute(context.Background(), "Pippin", domain.LevelUpOptions{}); err == nil {
+		t.Fatalf("expected error when ASI choice is missing at level 4")
+	}
+	if _, err := service.Execute(context.Background(), "Pippin", domain.LevelUpOptions{ASI: []string{"dex"}}); err == nil {
+		t.Fatalf("expected error when ASI would exceed 20")
+	}
+
+	msg, err := service.Execute(context.Background(), "Pippin", domain.LevelUpOptions{ASI: []string{"dex", "con"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.AbilityScores.Dex != 20 || char.AbilityScores.Con != 13 {
+		t.Errorf("expected DEX 20 and CON 13, got %d and %d", char.AbilityScores.Dex, char.AbilityScores.Con)
+	}
+	if !strings.Contains(msg, "DEX: +1 (now 20)") {
+		t.Errorf("expected ASI in summary, got %s", msg)
+	}
+}
+
+func TestLevelUpServiceRolledHPIsDeterministic(t *testing.T) {
+	newChar := func() *domain.Character {
+		c := &domain.Character{Name: "Sam", Class: "Fighter", Level: 1,
+			AbilityScores: domain.AbilityScores{Str: 15, Dex: 10, Con: 10, Int: 10, Wis: 10, Cha: 10}}
+		c.UpdateStats()
+		return c
+	}
+	first, second := newChar(), newChar()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Sam": first, "Sam2": second}}
+	service := &LevelUpService{Repo: repo}
+
+	opts := domain.LevelUpOptions{HPMethod: domain.HPMethodRoll, Seed: 99}
+	if _, err := service.Execute(context.Background(), "Sam", opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := service.Execute(context.Background(), "Sam2", opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.MaxHP != second.MaxHP || len(first.HitPointRolls) != 1 {
+		t.Errorf("expected identical rolled HP, got %d and %d", first.MaxHP, second.MaxHP)
+	}
+	if roll := first.HitPointRolls[0]; roll < 1 || roll > 10 {
+		t.Errorf("expected d10 roll, got %d", roll)
+	}
+}
+
+func TestLevelUpServiceMulticlass(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Elminster",
+		Class:         "cleric",
+		Level:         3,
+		AbilityScores: domain.AbilityScores{Str: 10, Dex: 12, Con: 12, Int: 12, Wis: 16, Cha: 10},
+	}
+	char.UpdateStats()
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Elminster": char}}
+	service := &LevelUpService{Repo: repo}
+
+	if _, err := service.Execute(context.Background(), "Elminster", domain.LevelUpOptions{Class: "wizard"}); err == nil {
+		t.Fatalf("expected multiclass prerequisite error for INT 12")
+	}
+
+	char.AbilityScores.Int = 13
+	for i := 0; i < 2; i++ {
+		if _, err := service.Execute(context.Background(), "Elminster", domain.LevelUpOptions{Class: "wizard"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if char.Level != 5 || char.ClassLevel("wizard") != 2 || char.ClassLevel("cleric") != 3 {
+		t.Fatalf("unexpected class levels: %+v", char.Classes)
+	}
+	if char.ProficiencyBonus != 3 {
+		t.Errorf("expected proficiency from total level, got %d", char.ProficiencyBonus)
+	}
+	want := map[int]int{1: 4, 2: 3, 3: 2}
+	for lvl, count := range want {
+		if char.SpellSlots[lvl] != count {
+			t.Errorf("expected %d level %d slots, got %d", count, lvl, char.SpellSlots[lvl])
+		}
+	}
+	if char.MaxHP != 31 {
+		t.Errorf("expected max HP 31, got %d", char.MaxHP)
+	}
+	if char.ClassSummary() != "cleric 3 / wizard 2" {
+		t.Errorf("unexpected class summary %q", char.ClassSummary())
+	}
+}
+
+func TestMulticlassSpellSlots(t *testing.T) {
+	slots := domain.GetMulticlassSpellSlots([]domain.ClassLevel{
+		{Class: "paladin", Level: 5},
+		{Class: "sorcerer", Level: 1},
+		{Class: "warlock", Level: 3},
+	})
+	if slots[1] != 4 || slots[2] != 2 || slots[3] != 0 {
+		t.Errorf("expected caster level 3 slots, got %v", slots)
+	}
+
+	pactLevel, pactSlots := domain.GetPactSlots(3)
+	if pactLevel != 2 || pactSlots != 2 {
+		t.Errorf("expected two 2nd level pact slots, got %d of level %d", pactSlots, pactLevel)
+	}
+}
+
+func TestMigrateSingleClassRecord(t *testing.T) {
+	char := &domain.Character{Name: "Old", Class: "wizard", Level: 7}
+	char.MigrateClasses()
+	if len(char.Classes) != 1 || char.Classes[0].Class != "wizard" || char.Classes[0].Level != 7 {
+		t.Errorf("expected single class entry, got %+v", char.Classes)
+	}
+}
@@ -17,6 +17,7 @@ func (s *PrepareSpellService) Execute(ctx context.Context, name string, spellNam
 	if err != nil {
 		return "", fmt.Errorf("character not found: %w", err)
 	}
+	char.UpdateStats()
 
 	spell := s.SpellRepo.FindSpellByName(spellName)
 	if spell == nil {
@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"starter_pack/domain"
+	"strings"
 	"testing"
 )
 
@@ -11,10 +13,10 @@ const SpellMagicMissile = "Magic Missile"
 
 func TestPrepareSpellServiceSuccess(t *testing.T) {
 	char := &domain.Character{
-		Name:  "Merlin",
-		Class: "Wizard",
-		Level: 3,
-		Spells: []domain.Spell{},
+		Name:      "Merlin",
+		Class:     "Wizard",
+		Level:     3,
+		Spellbook: []domain.Spell{{Name: SpellMagicMissile, Level: 1, Class: []string{"wizard"}}},
 	}
 	repo := &MockCharacterRepo{
 		Characters: map[string]*domain.Character{"Merlin": char},
@@ -33,8 +35,11 @@ func TestPrepareSpellServiceSuccess(t *testing.T) {
 	if msg != "Prepared spell "+SpellMagicMissile {
 		t.Errorf("unexpected message: %s", msg)
 	}
-	if len(char.Spells) != 1 || char.Spells[0].Name != SpellMagicMissile {
-		t.Errorf("spell not added to character")
+	if len(char.PreparedSpells) != 1 || char.PreparedSpells[0].Name != SpellMagicMissile {
+		t.Errorf("spell not prepared on character")
+	}
+	if len(char.Spellbook) != 1 {
+		t.Errorf("expected spellbook to be unchanged, got %v", char.Spellbook)
 	}
 }
 
@@ -64,7 +69,8 @@ func TestPrepareSpellServiceSpellNotFound(t *testing.T) {
 }
 
 func TestPrepareSpellServiceSaveError(t *testing.T) {
-	char := &domain.Character{Name: "Merlin", Class: "Wizard", Level: 3}
+	char := &domain.Character{Name: "Merlin", Class: "Wizard", Level: 3,
+		Spellbook: []domain.Spell{{Name: SpellMagicMissile, Level: 1, Class: []string{"wizard"}}}}
 	repo := &MockCharacterRepo{
 		Characters: map[string]*domain.Character{"Merlin": char},
 		SaveErr:    errors.New("save failed"),
@@ -79,3 +85,118 @@ func TestPrepareSpellServiceSaveError(t *testing.T) {
 		t.Errorf("expected save error, got %v", err)
 	}
 }
+
+func TestPrepareSpellServiceLimits(t *testing.T) {
+	cleric := &domain.Character{Name: "Jozan", Class: "cleric", Level: 1, AbilityScores: domain.AbilityScores{Wis: 14}}
+	paladin := &domain.Character{Name: "Ser Aldo", Class: "paladin", Level: 5, AbilityScores: domain.AbilityScores{Cha: 14}}
+	wizard := &domain.Character{Name: "Merlin", Class: "wizard", Level: 3}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{
+		"Jozan": cleric, "Ser Aldo": paladin, "Merlin": wizard,
+	}}
+	spellRepo := &MockSpellRepo{Spells: map[string]domain.Spell{
+		"Bless":           {Name: "Bless", Level: 1, Class: []string{"cleric", "paladin"}},
+		"Cure Wounds":     {Name: "Cure Wounds", Level: 1, Class: []string{"cleric", "paladin"}},
+		"Healing Word":    {Name: "Healing Word", Level: 1, Class: []string{"cleric"}},
+		"Shield of Faith": {Name: "Shield of Faith", Level: 1, Class: []string{"cleric", "paladin"}},
+		"Heroism":         {Name: "Heroism", Level: 1, Class: []string{"paladin"}},
+		"Sacred Flame":    {Name: "Sacred Flame", Level: 0, Class: []string{"cleric"}},
+		SpellMagicMissile: {Name: SpellMagicMissile, Level: 1, Class: []string{"wizard"}},
+	}}
+	service := &PrepareSpellService{Repo: repo, SpellRepo: spellRepo}
+	ctx := context.Background()
+
+	for _, spell := range []string{"Bless", "Cure Wounds", "Healing Word"} {
+		if _, err := service.Execute(ctx, "Jozan", spell); err != nil {
+			t.Fatalf("unexpected error preparing %s: %v", spell, err)
+		}
+	}
+	if _, err := service.Execute(ctx, "Jozan", "Shield of Faith"); err == nil || !strings.Contains(err.Error(), "unprepare one first") {
+		t.Errorf("expected cleric limit of WIS mod + level, got %v", err)
+	}
+	if _, err := service.Execute(ctx, "Jozan", "Bless"); err == nil || !strings.Contains(err.Error(), "already prepared") {
+		t.Errorf("expected duplicate preparation error, got %v", err)
+	}
+	if _, err := service.Execute(ctx, "Jozan", "Sacred Flame"); err == nil {
+		t.Errorf("expected cantrips not to be prepared")
+	}
+
+	if got := paladin.MaxPreparedSpells("paladin"); got != 4 {
+		t.Errorf("expected paladin to prepare CHA mod + half level, got %d", got)
+	}
+
+	if _, err := service.Execute(ctx, "Merlin", SpellMagicMissile); err == nil || !strings.Contains(err.Error(), "spellbook") {
+		t.Errorf("expected wizard to prepare only from the spellbook, got %v", err)
+	}
+}
+
+func TestUnprepareSpellService(t *testing.T) {
+	char := &domain.Character{
+		Name:           "Jozan",
+		Class:          "cleric",
+		Level:          3,
+		AbilityScores:  domain.AbilityScores{Wis: 16},
+		PreparedSpells: []domain.Spell{{Name: "Bless", Level: 1, Class: []string{"cleric"}}},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Jozan": char}}
+	service := &UnprepareSpellService{Repo: repo}
+
+	msg, err := service.Execute(context.Background(), "Jozan", "bless")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg != "Unprepared spell Bless" || len(char.PreparedSpells) != 0 {
+		t.Errorf("expected Bless unprepared, got %q and %v", msg, char.PreparedSpells)
+	}
+	if _, err := service.Execute(context.Background(), "Jozan", "Bless"); err == nil {
+		t.Errorf("expected error unpreparing a spell that is not prepared")
+	}
+
+	cast := &CastSpellService{Repo: repo}
+	if _, err := cast.Execute(context.Background(), "Jozan", "Bless", 0); err == nil || !strings.Contains(err.Error(), "has not prepared or learned") {
+		t.Errorf("expected unprepared spell to be uncastable, got %v", err)
+	}
+}
+
+func TestLegacyPreparedSpellsAreMigrated(t *testing.T) {
+	legacy := `{"Name": "Qui-Gon Jinn", "Race": "human", "Class": "cleric", "Level": 10,
+		"AbilityScores": {"Str": 15, "Dex": 9, "Con": 11, "Int": 14, "Wis": 16, "Cha": 13},
+		"Spells": [{"Name": "Command", "Level": 1, "Class": ["cleric", "paladin"]},
+			{"Name": "Beacon of Hope", "Level": 3, "Class": ["cleric"]}]}`
+	var char domain.Character
+	if err := json.Unmarshal([]byte(legacy), &char); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{char.Name: &char}}
+
+	output, err := (&CastSpellService{Repo: repo}).Execute(context.Background(), "Qui-Gon Jinn", "Command", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "casts Command with a level 1 slot") {
+		t.Errorf("unexpected output %q", output)
+	}
+	if !char.IsPrepared("Beacon of Hope") || len(char.Spells) != 0 {
+		t.Errorf("expected legacy spells to move to prepared spells, got %v and %v", char.Spells, char.PreparedSpells)
+	}
+
+	wizard := &domain.Character{Name: "Merlin", Class: "wizard", Level: 3,
+		Spells: []domain.Spell{{Name: SpellMagicMissile, Level: 1, Class: []string{"wizard"}}}}
+	wizard.UpdateStats()
+	if _, ok := wizard.InSpellbook(SpellMagicMissile); !ok || !wizard.IsPrepared(SpellMagicMissile) || len(wizard.Spells) != 0 {
+		t.Errorf("expected legacy wizard spell in the spellbook and prepared, got %v, %v and %v",
+			wizard.Spells, wizard.Spellbook, wizard.PreparedSpells)
+	}
+}
+
+func TestLearnSpellServiceRefersPreparedCastersToPrepare(t *testing.T) {
+	char := &domain.Character{Name: "Jozan", Class: "cleric", Level: 3}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Jozan": char}}
+	spellRepo := &MockSpellRepo{Spells: map[string]domain.Spell{
+		"Bless": {Name: "Bless", Level: 1, Class: []string{"cleric"}},
+	}}
+
+	_, err := (&LearnSpellService{Repo: repo, SpellRepo: spellRepo}).Execute(context.Background(), "Jozan", "Bless")
+	if err == nil || !strings.Contains(err.Error(), "use prepare-spell") {
+		t.Errorf("expected clerics to prepare rather than learn spells, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"starter_pack/domain"
+)
+
+type UseResourceService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *UseResourceService) Execute(ctx context.Context, name, resource string, amount int) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	used, err := char.UseResource(resource, amount)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("%s uses %d %s, %s remaining", char.Name, amount, used.Name, formatResourceUses(used)), nil
+}
+
+type RestoreResourceService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *RestoreResourceService) Execute(ctx context.Context, name, resource string, amount int) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	var msg string
+	if resource == "" || strings.EqualFold(resource, "all") {
+		restored := char.RestoreAllResources()
+		msg = fmt.Sprintf("%s restores all resources (%s)", char.Name, joinOrNone(restored))
+	} else {
+		restored, err := char.RestoreResource(resource, amount)
+		if err != nil {
+			return "", err
+		}
+		msg = fmt.Sprintf("%s restores %s, %s remaining", char.Name, restored.Name, formatResourceUses(restored))
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return msg, nil
+}
+
+func formatResourceUses(r domain.ClassResource) string {
+	if r.Unlimited {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d/%d", r.Current, r.Max)
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func TestUseResourceServiceTracksRage(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Krusk",
+		Class:         "barbarian",
+		Level:         3,
+		AbilityScores: domain.AbilityScores{Str: 16, Dex: 14, Con: 16, Int: 8, Wis: 10, Cha: 8},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Krusk": char}}
+	use := &UseResourceService{Repo: repo}
+
+	output, err := use.Execute(context.Background(), "Krusk", "rage", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Rage, 2/3 remaining") {
+		t.Errorf("unexpected output %q", output)
+	}
+	if _, err := use.Execute(context.Background(), "Krusk", "rage", 3); err == nil {
+		t.Errorf("expected error when spending more rages than remain")
+	}
+	if _, err := use.Execute(context.Background(), "Krusk", "rgae", 1); err == nil || !strings.Contains(err.Error(), "did you mean: rage") {
+		t.Errorf("expected suggestion for misspelled resource, got %v", err)
+	}
+
+	sheet, err := (&CharacterSheetService{Repo: repo}).Execute(context.Background(), "Krusk", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(sheet, "## Resources") || !strings.Contains(sheet, "**Rage**: 2/3 (recovers on long rest)") {
+		t.Errorf("expected rage tracker on sheet, got:\n%s", sheet)
+	}
+
+	if _, err := (&ShortRestService{Repo: repo}).Execute(context.Background(), "Krusk", domain.ShortRestOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rage, _ := char.Resource("rage"); rage.Current != 2 {
+		t.Errorf("expected rage not restored by a short rest, got %d", rage.Current)
+	}
+	if _, err := (&LongRestService{Repo: repo}).Execute(context.Background(), "Krusk"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rage, _ := char.Resource("rage"); rage.Current != 3 {
+		t.Errorf("expected rage restored by a long rest, got %d", rage.Current)
+	}
+}
+
+func TestResourcesDeriveFromClassLevelAndAbility(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Lyra",
+		Classes:       []domain.ClassLevel{{Class: "bard", Level: 4}, {Class: "monk", Level: 5}},
+		AbilityScores: domain.AbilityScores{Dex: 14, Wis: 14, Cha: 16},
+	}
+	char.UpdateStats()
+
+	inspiration, err := char.Resource("bardic inspiration")
+	if err != nil || inspiration.Max != 3 || inspiration.Recovery != domain.RecoveryLongRest {
+		t.Errorf("expected 3 bardic inspiration uses recovering on a long rest, got %+v (%v)", inspiration, err)
+	}
+	ki, err := char.Resource("Ki")
+	if err != nil || ki.Max != 5 || ki.Recovery != domain.RecoveryShortRest {
+		t.Errorf("expected 5 ki points recovering on a short rest, got %+v (%v)", ki, err)
+	}
+
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Lyra": char}}
+	if _, err := (&UseResourceService{Repo: repo}).Execute(context.Background(), "Lyra", "ki", 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := (&RestoreResourceService{Repo: repo}).Execute(context.Background(), "Lyra", "ki", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Ki, 3/5 remaining") {
+		t.Errorf("unexpected output %q", output)
+	}
+	if _, err := (&RestoreResourceService{Repo: repo}).Execute(context.Background(), "Lyra", "all", 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ki, _ := char.Resource("ki"); ki.Current != 5 {
+		t.Errorf("expected all ki restored, got %d", ki.Current)
+	}
+}
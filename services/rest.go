@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"starter_pack/domain"
+)
+
+type ShortRestService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *ShortRestService) Execute(ctx context.Context, name string, opts domain.ShortRestOptions) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	if strings.ToLower(opts.HPMethod) == domain.HPMethodRoll && opts.Seed == 0 {
+		opts.Seed = time.Now().UnixNano()
+	}
+
+	summary, err := char.ShortRest(opts)
+	if err != nil {
+		return "", fmt.Errorf("cannot take a short rest: %w", err)
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return formatRestSummary(char, "short", summary), nil
+}
+
+type LongRestService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *LongRestService) Execute(ctx context.Context, name string) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	summary, err := char.LongRest()
+	if err != nil {
+		return "", fmt.Errorf("cannot take a long rest: %w", err)
+	}
+	char.UpdateStats()
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return formatRestSummary(char, "long", summary), nil
+}
+
+func formatRestSummary(c *domain.Character, kind string, summary *domain.RestSummary) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s finishes a %s rest\n", c.Name, kind))
+	if len(summary.HitDiceSpent) > 0 {
+		sb.WriteString(fmt.Sprintf("Hit dice spent: %s", domain.FormatHitDice(summary.HitDiceSpent)))
+		if len(summary.HitDiceRolls) > 0 {
+			sb.WriteString(fmt.Sprintf(" (rolled %v)", summary.HitDiceRolls))
+		}
+		sb.WriteString("\n")
+	}
+	if len(summary.HitDiceRecovered) > 0 {
+		sb.WriteString(fmt.Sprintf("Hit dice recovered: %s\n", domain.FormatHitDice(summary.HitDiceRecovered)))
+	}
+	sb.WriteString(fmt.Sprintf("Hit points: +%d, HP %s\n", summary.HPRestored, formatHitPoints(c)))
+	sb.WriteString(fmt.Sprintf("Hit dice remaining: %s\n", domain.FormatHitDice(c.HitDiceRemaining())))
+	if summary.SpellSlotsRestored > 0 {
+		sb.WriteString(fmt.Sprintf("Spell slots restored: %d\n", summary.SpellSlotsRestored))
+	}
+	if summary.PactSlotsRestored > 0 {
+		sb.WriteString(fmt.Sprintf("Pact slots restored: %d\n", summary.PactSlotsRestored))
+	}
+	if len(summary.ResourcesRestored) > 0 {
+		sb.WriteString(fmt.Sprintf("Resources restored: %s\n", strings.Join(summary.ResourcesRestored, ", ")))
+	}
+	if summary.ExhaustionReduced {
+		sb.WriteString(fmt.Sprintf("Exhaustion reduced to %d\n", c.Exhaustion))
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func TestShortRestSpendsHitDice(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Bruenor",
+		Class:         "fighter",
+		Level:         4,
+		AbilityScores: domain.AbilityScores{Str: 16, Dex: 12, Con: 14, Int: 10, Wis: 10, Cha: 8},
+	}
+	char.UpdateStats()
+	char.CurrentHP = 10
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Bruenor": char}}
+	service := &ShortRestService{Repo: repo}
+
+	output, err := service.Execute(context.Background(), "Bruenor", domain.ShortRestOptions{HitDice: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.CurrentHP != 26 || char.HitDiceSpent[10] != 2 {
+		t.Errorf("expected 26 HP after two averaged d10s, got %d (spent %v)", char.CurrentHP, char.HitDiceSpent)
+	}
+	if !strings.Contains(output, "Hit dice remaining: 2d10") {
+		t.Errorf("unexpected output %q", output)
+	}
+
+	if _, err := service.Execute(context.Background(), "Bruenor", domain.ShortRestOptions{HitDice: 3}); err == nil {
+		t.Errorf("expected error spending more hit dice than remain")
+	}
+
+	rolled, err := service.Execute(context.Background(), "Bruenor", domain.ShortRestOptions{HitDice: 1, HPMethod: "roll", Seed: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(rolled, "rolled") || char.HitDiceSpent[10] != 3 {
+		t.Errorf("expected a rolled hit die, got %q", rolled)
+	}
+}
+
+func TestShortRestRestoresPactSlots(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Hexa",
+		Classes:       []domain.ClassLevel{{Class: "fighter", Level: 2}, {Class: "warlock", Level: 3}},
+		AbilityScores: domain.AbilityScores{Con: 10, Cha: 16},
+		PactSlotsUsed: 2,
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Hexa": char}}
+	service := &ShortRestService{Repo: repo}
+
+	output, err := service.Execute(context.Background(), "Hexa", domain.ShortRestOptions{HitDice: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.PactSlotsRemaining() != 2 || !strings.Contains(output, "Pact slots restored: 2") {
+		t.Errorf("expected pact slots restored, got %q", output)
+	}
+	if char.HitDiceSpent[10] != 2 || char.HitDiceSpent[8] != 1 {
+		t.Errorf("expected largest hit dice spent first, got %v", char.HitDiceSpent)
+	}
+}
+
+func TestLongRestRestoresResources(t *testing.T) {
+	char := &domain.Character{
+		Name:           "Elara",
+		Class:          "wizard",
+		Level:          5,
+		AbilityScores:  domain.AbilityScores{Con: 14, Int: 16},
+		CurrentHP:      3,
+		TempHP:         4,
+		HitDiceSpent:   map[int]int{6: 4},
+		SpellSlotsUsed: map[int]int{1: 2, 3: 1},
+		Exhaustion:     4,
+		ResourcesUsed:  map[string]int{"Arcane Recovery": 1},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Elara": char}}
+	service := &LongRestService{Repo: repo}
+
+	if _, err := (&ShortRestService{Repo: repo}).Execute(context.Background(), "Elara", domain.ShortRestOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if recovery, _ := char.Resource("Arcane Recovery"); recovery.Recovery != domain.RecoveryDawn || recovery.Current != 0 {
+		t.Errorf("expected dawn resource to stay spent after a short rest, got %+v", recovery)
+	}
+
+	output, err := service.Execute(context.Background(), "Elara")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if char.Exhaustion != 3 {
+		t.Errorf("expected exhaustion reduced to 3, got %d", char.Exhaustion)
+	}
+	if char.CurrentHP != char.MaxHP || char.MaxHP != 32 || char.TempHP != 0 {
+		t.Errorf("expected full HP 32 without temp HP, got %d/%d (+%d)", char.CurrentHP, char.MaxHP, char.TempHP)
+	}
+	if char.HitDiceSpent[6] != 2 {
+		t.Errorf("expected half of the hit dice recovered, got %v", char.HitDiceSpent)
+	}
+	if char.SpellSlotsRemaining()[1] != 4 || char.SpellSlotsRemaining()[3] != 2 {
+		t.Errorf("expected all spell slots restored, got %v", char.SpellSlotsRemaining())
+	}
+	if recovery, _ := char.Resource("Arcane Recovery"); recovery.Current != 1 {
+		t.Errorf("expected dawn resource restored by a long rest, got %+v", recovery)
+	}
+	if !strings.Contains(output, "Spell slots restored: 3") || !strings.Contains(output, "Exhaustion reduced to 3") {
+		t.Errorf("unexpected output %q", output)
+	}
+}
+
+func TestLongRestRefusedAtZeroHPOrFatalExhaustion(t *testing.T) {
+	downed := &domain.Character{Name: "Elara", Class: "wizard", Level: 5, AbilityScores: domain.AbilityScores{Con: 14},
+		MaxHP: 32, CurrentHP: 0, SpellSlotsUsed: map[int]int{1: 2}}
+	dead := &domain.Character{Name: "Thok", Class: "fighter", Level: 3, AbilityScores: domain.AbilityScores{Con: 14},
+		CurrentHP: 10, HitDiceSpent: map[int]int{10: 2}, Exhaustion: domain.MaxExhaustion}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Elara": downed, "Thok": dead}}
+	service := &LongRestService{Repo: repo}
+
+	if _, err := service.Execute(context.Background(), "Elara"); err == nil {
+		t.Error("expected a long rest at 0 HP to be refused")
+	}
+	if downed.CurrentHP != 0 || downed.SpellSlotsUsed[1] != 2 {
+		t.Errorf("expected no rest benefits at 0 HP, got %d HP and slots used %v", downed.CurrentHP, downed.SpellSlotsUsed)
+	}
+
+	if _, err := service.Execute(context.Background(), "Thok"); err == nil {
+		t.Error("expected a long rest at exhaustion 6 to be refused")
+	}
+	if dead.Exhaustion != domain.MaxExhaustion || dead.HitDiceSpent[10] != 2 {
+		t.Errorf("expected no rest benefits at exhaustion 6, got exhaustion %d and hit dice spent %v", dead.Exhaustion, dead.HitDiceSpent)
+	}
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"starter_pack/domain"
+)
+
+type SetProfileService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *SetProfileService) Execute(ctx context.Context, name string, update domain.ProfileUpdate) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+
+	changed, err := char.UpdateProfile(update)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("Updated %s for %s", strings.Join(changed, ", "), char.Name), nil
+}
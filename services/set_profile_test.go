@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"starter_pack/domain"
+	"strings"
+	"testing"
+)
+
+func TestSetProfileServiceUpdatesFields(t *testing.T) {
+	char := &domain.Character{Name: "Elara", Class: "wizard", Level: 1,
+		Profile: domain.Profile{Ideal: "Knowledge.", Bond: "My tomes."}}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Elara": char}}
+	service := &SetProfileService{Repo: repo}
+
+	output, err := service.Execute(context.Background(), "Elara", domain.ProfileUpdate{
+		PlayerName: "Sam",
+		Alignment:  "cg",
+		Backstory:  "Raised in the tower of Candlekeep.",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "alignment, player name, backstory") {
+		t.Errorf("unexpected output %q", output)
+	}
+	if char.Profile.Alignment != "Chaotic Good" || char.Profile.PlayerName != "Sam" || char.Profile.Ideal != "Knowledge." {
+		t.Errorf("unexpected profile %+v", char.Profile)
+	}
+
+	sheet, err := (&CharacterSheetService{Repo: repo}).Execute(context.Background(), "Elara", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, line := range []string{"## Profile", "Player: Sam", "Alignment: Chaotic Good", "Bond: My tomes.", "Backstory:\nRaised in the tower"} {
+		if !strings.Contains(sheet, line) {
+			t.Errorf("expected %q on sheet, got:\n%s", line, sheet)
+		}
+	}
+}
+
+func TestSetProfileServiceRejectsInvalidAlignment(t *testing.T) {
+	char := &domain.Character{Name: "Elara"}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Elara": char}}
+	service := &SetProfileService{Repo: repo}
+
+	_, err := service.Execute(context.Background(), "Elara", domain.ProfileUpdate{Alignment: "lawful goodd"})
+	var validation *domain.ValidationError
+	if !errors.As(err, &validation) || len(validation.Suggestions) == 0 || validation.Suggestions[0] != "lawful good" {
+		t.Errorf("expected alignment suggestion, got %v", err)
+	}
+	if char.Profile.Alignment != "" {
+		t.Errorf("expected alignment unchanged, got %q", char.Profile.Alignment)
+	}
+
+	if _, err := service.Execute(context.Background(), "Elara", domain.ProfileUpdate{}); err == nil {
+		t.Errorf("expected error when no fields are given")
+	}
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -22,14 +23,24 @@ func (s *CharacterSheetService) Execute(ctx context.Context, name string, format
 	if strings.ToLower(format) != "markdown" {
 		return "", fmt.Errorf("unsupported format: %s", format)
 	}
+	char.UpdateStats()
 
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("# %s\n\n", char.Name))
 	sb.WriteString(s.buildCharacterSection(char))
 	sb.WriteString(s.buildAbilityScoresSection(char))
+	sb.WriteString(s.buildRaceSection(char))
+	sb.WriteString(s.buildBackgroundSection(char))
+	sb.WriteString(s.buildProfileSection(char))
+	sb.WriteString(s.buildSavingThrowsSection(char))
 	sb.WriteString(s.buildSkillsSection(char))
+	sb.WriteString(s.buildOtherProficienciesSection(char))
 	sb.WriteString(s.buildEquipmentSection(char))
 	sb.WriteString(s.buildCombatStatsSection(char))
+	sb.WriteString(s.buildConditionsSection(char))
+	sb.WriteString(s.buildResourcesSection(char))
+	sb.WriteString(s.buildFeaturesSection(char))
+	sb.WriteString(s.buildFeatsSection(char))
 	sb.WriteString(s.buildSpellSection(char))
 
 	return sb.String(), nil
@@ -38,12 +49,16 @@ func (s *CharacterSheetService) Execute(ctx context.Context, name string, format
 func (s *CharacterSheetService) buildCharacterSection(char *domain.Character) string {
 	var sb strings.Builder
 	sb.WriteString("## Character\n")
-	sb.WriteString(fmt.Sprintf("Class: %s\n", char.Class))
+	sb.WriteString(fmt.Sprintf("Class: %s\n", char.ClassSummary()))
 	sb.WriteString(fmt.Sprintf("Race: %s\n", char.Race))
 	sb.WriteString(fmt.Sprintf("Background: %s\n", char.Background))
 	sb.WriteString(fmt.Sprintf("Level: %d\n", char.Level))
+	sb.WriteString(fmt.Sprintf("Experience points: %s\n", formatExperience(char)))
 	sb.WriteString(fmt.Sprintf("Proficiency bonus: +%d\n", char.ProficiencyBonus))
-	sb.WriteString(fmt.Sprintf("Passive perception: %d\n\n", char.PassivePerception))
+	for _, skill := range domain.PassiveSkills {
+		sb.WriteString(fmt.Sprintf("Passive %s: %d\n", strings.ToLower(skill), char.PassiveScore(skill)))
+	}
+	sb.WriteString("\n")
 	return sb.String()
 }
 
@@ -59,6 +74,91 @@ func (s *CharacterSheetService) buildAbilityScoresSection(char *domain.Character
 	return sb.String()
 }
 
+func (s *CharacterSheetService) buildRaceSection(char *domain.Character) string {
+	race, ok := domain.GetRace(char.Race)
+	if !ok {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("## Race: %s\n", race.Name))
+	sb.WriteString(fmt.Sprintf("Ability score increase: %s\n", formatAbilityBonuses(char.RacialBonuses())))
+	sb.WriteString(fmt.Sprintf("Size: %s\n", char.Size))
+	sb.WriteString(fmt.Sprintf("Speed: %d ft\n", char.Speed))
+	if char.Darkvision > 0 {
+		sb.WriteString(fmt.Sprintf("Darkvision: %d ft\n", char.Darkvision))
+	}
+	if len(char.Resistances) > 0 {
+		sb.WriteString(fmt.Sprintf("Resistances: %s\n", strings.Join(char.Resistances, ", ")))
+	}
+	for _, t := range race.Traits {
+		sb.WriteString(fmt.Sprintf("- **%s**: %s\n", t.Name, t.Description))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+func (s *CharacterSheetService) buildBackgroundSection(char *domain.Character) string {
+	background, ok := char.BackgroundData()
+	if !ok {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("## Background: %s\n", background.Name))
+	sb.WriteString(fmt.Sprintf("- **%s**: %s\n", background.Feature.Name, background.Feature.Description))
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+func (s *CharacterSheetService) buildConditionsSection(char *domain.Character) string {
+	conditions := char.ActiveConditions()
+	if len(conditions) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("## Conditions\n")
+	for _, condition := range conditions {
+		sb.WriteString(fmt.Sprintf("- **%s**: %s\n", condition.Name, condition.Summary))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+func (s *CharacterSheetService) buildResourcesSection(char *domain.Character) string {
+	resources := char.Resources()
+	if len(resources) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("## Resources\n")
+	for _, r := range resources {
+		sb.WriteString(fmt.Sprintf("- **%s**: %s (recovers on %s)\n", r.Name, formatResourceUses(r), r.Recovery))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+func (s *CharacterSheetService) buildProfileSection(char *domain.Character) string {
+	profile := formatProfile(char.Profile)
+	if profile == "" {
+		return ""
+	}
+	return "## Profile\n" + profile + "\n"
+}
+
+func (s *CharacterSheetService) buildSavingThrowsSection(char *domain.Character) string {
+	var sb strings.Builder
+	sb.WriteString("## Saving Throws\n")
+	for _, save := range char.SavingThrows() {
+		marked := "[]"
+		if save.Proficient {
+			marked = "[x]"
+		}
+		sb.WriteString(fmt.Sprintf("%s %s %+d\n", marked, save.Ability, save.Bonus))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
 func (s *CharacterSheetService) buildSkillsSection(char *domain.Character) string {
 	repo := domain.NewSkillRepository()
 	var sb strings.Builder
@@ -68,7 +168,33 @@ func (s *CharacterSheetService) buildSkillsSection(char *domain.Character) strin
 		if repo.HasSkill(char.SkillProficiencies, skill.Name) {
 			marked = "[x]"
 		}
-		sb.WriteString(fmt.Sprintf("%s %s (%s)\n", marked, skill.Name, skill.Ability))
+		sb.WriteString(fmt.Sprintf("%s %s (%s) %+d%s\n", marked, skill.Name, skill.Ability,
+			char.SkillBonus(skill), proficiencyNote(char.SkillProficiency(skill.Name))))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+func (s *CharacterSheetService) buildOtherProficienciesSection(char *domain.Character) string {
+	var sb strings.Builder
+	sb.WriteString("## Other Proficiencies & Languages\n")
+	sb.WriteString(fmt.Sprintf("Armor: %s\n", joinOrNone(char.ArmorProficiencies())))
+	sb.WriteString(fmt.Sprintf("Weapons: %s\n", joinOrNone(char.WeaponProficiencies())))
+	sb.WriteString(fmt.Sprintf("Tools: %s\n", joinOrNone(char.ToolProficiencies())))
+	sb.WriteString(fmt.Sprintf("Languages: %s\n", formatLanguages(char.Languages, char.LanguageChoices())))
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+func (s *CharacterSheetService) buildFeatsSection(char *domain.Character) string {
+	if len(char.Feats) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("## Feats\n")
+	for _, f := range char.Feats {
+		feat, _ := domain.GetFeat(f.Name)
+		sb.WriteString(fmt.Sprintf("- **%s**: %s\n", formatFeat(f), feat.Description))
 	}
 	sb.WriteString("\n")
 	return sb.String()
@@ -86,6 +212,10 @@ func (s *CharacterSheetService) buildEquipmentSection(char *domain.Character) st
 	if char.Equipment.Shield != nil {
 		sb.WriteString(fmt.Sprintf("Shield: %s\n", char.Equipment.Shield.Name))
 	}
+	if len(char.Inventory) > 0 {
+		sb.WriteString(fmt.Sprintf("Inventory: %s\n", strings.Join(char.Inventory, ", ")))
+	}
+	sb.WriteString(fmt.Sprintf("Currency: %s\n", formatCurrency(char.Currency)))
 	sb.WriteString("\n")
 	return sb.String()
 }
@@ -94,7 +224,29 @@ func (s *CharacterSheetService) buildCombatStatsSection(char *domain.Character)
 	var sb strings.Builder
 	sb.WriteString("## Combat stats\n")
 	sb.WriteString(fmt.Sprintf("Armor class: %d\n", char.ArmorClass))
-	sb.WriteString(fmt.Sprintf("Initiative bonus: %+d\n\n", char.Initiative))
+	sb.WriteString(fmt.Sprintf("Initiative bonus: %+d\n", char.Initiative))
+	sb.WriteString(fmt.Sprintf("Hit points: %d/%d\n", char.CurrentHP, char.MaxHP))
+	sb.WriteString(fmt.Sprintf("Temporary hit points: %d\n", char.TempHP))
+	sb.WriteString(fmt.Sprintf("Hit dice: %s (of %s)\n\n",
+		domain.FormatHitDice(char.HitDiceRemaining()), domain.FormatHitDice(char.HitDice())))
+	return sb.String()
+}
+
+func (s *CharacterSheetService) buildFeaturesSection(char *domain.Character) string {
+	features := char.Features()
+	if len(features) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("## Features & Traits\n")
+	for _, f := range features {
+		sb.WriteString(fmt.Sprintf("- **%s** (%s %d): %s", f.Name, f.Source, f.Level, f.Description))
+		if detail := f.Detail(); detail != "" {
+			sb.WriteString(fmt.Sprintf(" [%s]", detail))
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
 	return sb.String()
 }
 
@@ -107,7 +259,7 @@ func (s *CharacterSheetService) buildSpellSection(char *domain.Character) string
 }
 
 func (s *CharacterSheetService) buildSpellSlotsSection(char *domain.Character) string {
-	if len(char.SpellSlots) == 0 {
+	if len(char.SpellSlots) == 0 && char.PactSlots == 0 {
 		return ""
 	}
 	var sb strings.Builder
@@ -118,7 +270,10 @@ func (s *CharacterSheetService) buildSpellSlotsSection(char *domain.Character) s
 	}
 	sort.Ints(keys)
 	for _, lvl := range keys {
-		sb.WriteString(fmt.Sprintf("Level %d: %d\n", lvl, char.SpellSlots[lvl]))
+		sb.WriteString(fmt.Sprintf("Level %d: %d/%d\n", lvl, char.SpellSlotsRemaining()[lvl], char.SpellSlots[lvl]))
+	}
+	if char.PactSlots > 0 {
+		sb.WriteString(fmt.Sprintf("Pact magic: %d/%d slots of level %d\n", char.PactSlotsRemaining(), char.PactSlots, char.PactSlotLevel))
 	}
 	sb.WriteString("\n")
 	return sb.String()
@@ -132,19 +287,34 @@ func (s *CharacterSheetService) buildSpellcastingSection(char *domain.Character)
 	sb.WriteString("## Spellcasting [leave empty on non-casters]\n")
 	sb.WriteString(fmt.Sprintf("Spellcasting ability: %s\n", FullAbilityName(char.SpellcastingAbility)))
 	sb.WriteString(fmt.Sprintf("Spell save DC: %d\n", char.SpellSaveDC))
-	sb.WriteString(fmt.Sprintf("Spell attack bonus: %+d\n\n", char.SpellAttackBonus))
+	sb.WriteString(fmt.Sprintf("Spell attack bonus: %+d\n", char.SpellAttackBonus))
+	sb.WriteString(formatSpellLimits(char))
+	sb.WriteString("\n")
 	return sb.String()
 }
 
 func (s *CharacterSheetService) buildSpellsByLevelSection(char *domain.Character) string {
-	if len(char.Spells) == 0 {
+	var all []domain.Spell
+	for _, sp := range slices.Concat(char.Spells, char.Spellbook, char.PreparedSpells) {
+		if !containsSpell(all, sp.Name) {
+			all = append(all, sp)
+		}
+	}
+	if len(all) == 0 {
 		return ""
 	}
 	var sb strings.Builder
 	sb.WriteString("## Spells [leave empty on non-casters, only add levels that contain spells]\n\n")
 	spellsByLevel := map[int][]string{}
-	for _, sp := range char.Spells {
-		spellsByLevel[sp.Level] = append(spellsByLevel[sp.Level], sp.Name)
+	for _, sp := range all {
+		name := sp.Name
+		if char.IsPrepared(sp.Name) {
+			name += " (prepared)"
+		}
+		if _, ok := char.InSpellbook(sp.Name); ok && sp.IsRitual() {
+			name += " (ritual)"
+		}
+		spellsByLevel[sp.Level] = append(spellsByLevel[sp.Level], name)
 	}
 
 	levels := make([]int, 0, len(spellsByLevel))
@@ -162,3 +332,12 @@ func (s *CharacterSheetService) buildSpellsByLevelSection(char *domain.Character
 	}
 	return sb.String()
 }
+
+func containsSpell(spells []domain.Spell, name string) bool {
+	for _, sp := range spells {
+		if sp.HasName(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -82,3 +82,219 @@ func TestCharacterSheetServiceUnsupportedFormat(t *testing.T) {
 		t.Errorf("expected unsupported format error, got %v", err)
 	}
 }
+
+func TestCharacterSheetServiceFeatures(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Lidda",
+		Class:         "rogue",
+		Level:         5,
+		AbilityScores: domain.AbilityScores{Str: 10, Dex: 16, Con: 12, Int: 10, Wis: 10, Cha: 10},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Lidda": char}}
+
+	service := &CharacterSheetService{Repo: repo}
+	output, err := service.Execute(context.Background(), "Lidda", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(output, "## Features & Traits") {
+		t.Errorf("expected features section")
+	}
+	if !strings.Contains(output, "**Sneak Attack** (rogue 1)") || !strings.Contains(output, "[3d6]") {
+		t.Errorf("expected sneak attack with 3d6 at level 5")
+	}
+	if !strings.Contains(output, "Uncanny Dodge") || strings.Contains(output, "Evasion") {
+		t.Errorf("expected only features up to level 5")
+	}
+}
+
+func TestUnarmoredDefenseFeature(t *testing.T) {
+	barbarian := &domain.Character{
+		Name:          "Krusk",
+		Class:         "barbarian",
+		Level:         1,
+		AbilityScores: domain.AbilityScores{Str: 16, Dex: 14, Con: 16, Int: 8, Wis: 10, Cha: 8},
+		Equipment:     domain.Equipment{Shield: &domain.Shield{Name: "shield"}},
+	}
+	barbarian.UpdateStats()
+	if barbarian.ArmorClass != 17 {
+		t.Errorf("expected barbarian AC 17 with shield, got %d", barbarian.ArmorClass)
+	}
+
+	monk := &domain.Character{
+		Name:          "Ember",
+		Class:         "monk",
+		Level:         1,
+		AbilityScores: domain.AbilityScores{Str: 10, Dex: 16, Con: 12, Int: 10, Wis: 16, Cha: 8},
+	}
+	monk.UpdateStats()
+	if monk.ArmorClass != 16 {
+		t.Errorf("expected monk AC 16, got %d", monk.ArmorClass)
+	}
+	monk.EquipShield("shield")
+	if monk.ArmorClass != 15 {
+		t.Errorf("expected monk to lose unarmored defense with a shield, got %d", monk.ArmorClass)
+	}
+}
+
+func TestCharacterSheetServiceRacialTraits(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Gimli",
+		Race:          "dwarf mountain",
+		Class:         "fighter",
+		Level:         1,
+		AbilityScores: domain.AbilityScores{Str: 16, Dex: 10, Con: 16, Int: 10, Wis: 10, Cha: 8},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Gimli": char}}
+
+	service := &CharacterSheetService{Repo: repo}
+	output, err := service.Execute(context.Background(), "Gimli", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"## Race: Mountain Dwarf", "Speed: 25 ft", "Darkvision: 60 ft",
+		"Languages: Common, Dwarvish", "Resistances: poison", "**Dwarven Armor Training**"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in sheet", want)
+		}
+	}
+}
+
+func TestRaceDataResolvesSubraces(t *testing.T) {
+	bonuses := domain.GetRacialBonuses("elf high")
+	if bonuses["Dex"] != 2 || bonuses["Int"] != 1 {
+		t.Errorf("expected +2 DEX +1 INT for high elf, got %v", bonuses)
+	}
+
+	hillDwarf := &domain.Character{Name: "Tordek", Race: "hill dwarf", Class: "cleric", Level: 3,
+		AbilityScores: domain.AbilityScores{Con: 10}}
+	hillDwarf.UpdateStats()
+	if hillDwarf.MaxHP != 8+5+5+3 {
+		t.Errorf("expected dwarven toughness HP bonus, got %d", hillDwarf.MaxHP)
+	}
+
+	halfling, ok := domain.GetRace("stout halfling")
+	if !ok || halfling.Size != domain.SizeSmall || halfling.Speed != 25 {
+		t.Errorf("expected small halfling with 25 ft speed, got %+v", halfling)
+	}
+}
+
+func TestCharacterSheetServiceSavingThrows(t *testing.T) {
+	char := &domain.Character{
+		Name:          "Varis",
+		Classes:       []domain.ClassLevel{{Class: "wizard", Level: 3}, {Class: "fighter", Level: 2}},
+		AbilityScores: domain.AbilityScores{Str: 14, Dex: 12, Con: 13, Int: 16, Wis: 10, Cha: 8},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Varis": char}}
+
+	service := &CharacterSheetService{Repo: repo}
+	output, err := service.Execute(context.Background(), "Varis", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, line := range []string{"## Saving Throws", "[x] INT +6", "[x] WIS +3", "[] STR +2", "[] CON +1"} {
+		if !strings.Contains(output, line) {
+			t.Errorf("expected %q on sheet, got:\n%s", line, output)
+		}
+	}
+}
+
+func TestSavingThrowsFromFeatures(t *testing.T) {
+	monk := &domain.Character{Name: "Ember", Class: "monk", Level: 14}
+	monk.UpdateStats()
+
+	if got := monk.SavingThrowProficiencies(); len(got) != len(domain.AbilityNames) {
+		t.Errorf("expected Diamond Soul to grant all saves, got %v", got)
+	}
+}
+
+func TestCharacterSheetServicePassiveScores(t *testing.T) {
+	char := &domain.Character{
+		Name:               "Lidda",
+		Class:              "rogue",
+		Level:              5,
+		AbilityScores:      domain.AbilityScores{Str: 8, Dex: 16, Con: 12, Int: 14, Wis: 12, Cha: 10},
+		SkillProficiencies: []string{"Perception", "Investigation", "Stealth", "Acrobatics"},
+		SkillExpertise:     []string{"Perception"},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Lidda": char}}
+
+	service := &CharacterSheetService{Repo: repo}
+	output, err := service.Execute(context.Background(), "Lidda", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, line := range []string{
+		"Passive perception: 17",
+		"Passive investigation: 15",
+		"Passive insight: 11",
+		"[x] Investigation (INT) +5 (proficient)",
+		"[] Arcana (INT) +2",
+	} {
+		if !strings.Contains(output, line) {
+			t.Errorf("expected %q on sheet, got:\n%s", line, output)
+		}
+	}
+	if char.PassivePerception != 17 {
+		t.Errorf("expected stored passive perception 17, got %d", char.PassivePerception)
+	}
+}
+
+func TestPassiveScoreWithAdvantage(t *testing.T) {
+	char := &domain.Character{
+		Name:               "Lidda",
+		Class:              "rogue",
+		Level:              5,
+		AbilityScores:      domain.AbilityScores{Wis: 14},
+		SkillProficiencies: []string{"Perception"},
+	}
+	char.UpdateStats()
+
+	if got := char.PassiveScore("Perception"); got != 15 {
+		t.Errorf("expected passive perception 15, got %d", got)
+	}
+	if got := char.PassiveScoreWith("Perception", true); got != 20 {
+		t.Errorf("expected passive perception 20 with advantage, got %d", got)
+	}
+	if _, err := char.AddCondition("poisoned"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := char.PassiveScoreWith("Perception", true); got != 15 {
+		t.Errorf("expected advantage and disadvantage to cancel out, got %d", got)
+	}
+}
+
+func TestCharacterSheetServiceOtherProficiencies(t *testing.T) {
+	char := &domain.Character{
+		Name:       "Sariel",
+		Race:       "high elf",
+		Background: "Sage",
+		Classes:    []domain.ClassLevel{{Class: "wizard", Level: 2}, {Class: "fighter", Level: 1}},
+	}
+	repo := &MockCharacterRepo{Characters: map[string]*domain.Character{"Sariel": char}}
+
+	service := &CharacterSheetService{Repo: repo}
+	output, err := service.Execute(context.Background(), "Sariel", "markdown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, line := range []string{
+		"## Other Proficiencies & Languages",
+		"Armor: light armor, medium armor, shields",
+		"Weapons: dagger, dart, sling, quarterstaff, light crossbow, simple weapons, martial weapons, longsword, shortsword, shortbow, longbow",
+		"Tools: none",
+		"Languages: Common, Elvish (+3 of your choice)",
+	} {
+		if !strings.Contains(output, line) {
+			t.Errorf("expected %q on sheet, got:\n%s", line, output)
+		}
+	}
+	if char.IsProficientWithArmor("Plate") {
+		t.Errorf("expected no heavy armor proficiency from multiclassing into fighter")
+	}
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"starter_pack/domain"
+)
+
+type ForgetSpellService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *ForgetSpellService) Execute(ctx context.Context, name string, spellName string) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	spell, err := char.ForgetSpell(spellName)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("Forgot spell %s", spell.Name), nil
+}
+
+type SwapSpellService struct {
+	Repo      domain.CharacterRepository
+	SpellRepo domain.SpellRepository
+}
+
+func (s *SwapSpellService) Execute(ctx context.Context, name, oldSpell, newSpell string) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+	char.UpdateStats()
+
+	spell := s.SpellRepo.FindSpellByName(newSpell)
+	if spell == nil {
+		return "", fmt.Errorf("spell not found: %s", newSpell)
+	}
+
+	if err := char.SwapSpell(oldSpell, *spell); err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("Replaced spell %s with %s", oldSpell, spell.Name), nil
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"starter_pack/domain"
+)
+
+type UnprepareSpellService struct {
+	Repo domain.CharacterRepository
+}
+
+func (s *UnprepareSpellService) Execute(ctx context.Context, name string, spellName string) (string, error) {
+	char, err := s.Repo.GetByName(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("character not found: %w", err)
+	}
+
+	spell, err := char.UnprepareSpell(spellName)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Repo.Save(ctx, char); err != nil {
+		return "", fmt.Errorf("failed to save character: %w", err)
+	}
+
+	return fmt.Sprintf("Unprepared spell %s", spell.Name), nil
+}
@@ -41,15 +41,23 @@ func (s *ViewCharacterService) Execute(ctx context.Context, name string) error {
 func PrintCharacter(c *domain.Character) {
 	printBasicInfo(c)
 	printAbilities(c)
+	printRace(c)
+	printBackground(c)
+	printProfile(c)
+	printSavingThrows(c)
 	printProficiencies(c)
 	printEquipment(c)
 	printSpells(c)
 	printCombatStats(c)
+	printConditions(c)
+	printResources(c)
+	printFeatures(c)
+	printFeats(c)
 }
 
 func printBasicInfo(c *domain.Character) {
-	fmt.Printf("Name: %s\nClass: %s\nRace: %s\nBackground: %s\nLevel: %d\n\n",
-		c.Name, c.Class, c.Race, c.Background, c.Level)
+	fmt.Printf("Name: %s\nClass: %s\nRace: %s\nBackground: %s\nLevel: %d\nExperience: %s\n\n",
+		c.Name, c.ClassSummary(), c.Race, c.Background, c.Level, formatExperience(c))
 }
 
 func printAbilities(c *domain.Character) {
@@ -69,10 +77,124 @@ func printAbilities(c *domain.Character) {
 	fmt.Printf("\nProficiency bonus: +%d\n", c.ProficiencyBonus)
 }
 
+func printRace(c *domain.Character) {
+	if _, ok := domain.GetRace(c.Race); !ok {
+		return
+	}
+	fmt.Printf("Racial ability score increase: %s\n", formatAbilityBonuses(c.RacialBonuses()))
+	fmt.Printf("Size: %s\nSpeed: %d ft\n", c.Size, c.Speed)
+	if c.Darkvision > 0 {
+		fmt.Printf("Darkvision: %d ft\n", c.Darkvision)
+	}
+	if len(c.Resistances) > 0 {
+		fmt.Printf("Resistances: %s\n", strings.Join(c.Resistances, ", "))
+	}
+	if len(c.RacialTraits) > 0 {
+		fmt.Printf("Racial traits: %s\n", strings.Join(c.RacialTraits, ", "))
+	}
+}
+
+func formatAbilityBonuses(bonuses map[string]int) string {
+	var parts []string
+	for _, ability := range domain.AbilityNames {
+		for name, bonus := range bonuses {
+			if strings.EqualFold(name, ability) && bonus != 0 {
+				parts = append(parts, fmt.Sprintf("%s %+d", ability, bonus))
+			}
+		}
+	}
+	return joinOrNone(parts)
+}
+
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	return strings.Join(values, ", ")
+}
+
+func formatLanguages(languages []string, choices int) string {
+	text := strings.Join(languages, ", ")
+	if choices > 0 {
+		text += fmt.Sprintf(" (+%d of your choice)", choices)
+	}
+	return text
+}
+
+func printBackground(c *domain.Character) {
+	background, ok := c.BackgroundData()
+	if !ok {
+		return
+	}
+	fmt.Printf("Background feature: %s - %s\n", background.Feature.Name, background.Feature.Description)
+}
+
+func printProfile(c *domain.Character) {
+	fmt.Print(formatProfile(c.Profile))
+}
+
+func formatProfile(p domain.Profile) string {
+	var sb strings.Builder
+	if p.PlayerName != "" {
+		sb.WriteString(fmt.Sprintf("Player: %s\n", p.PlayerName))
+	}
+	if p.Alignment != "" {
+		sb.WriteString(fmt.Sprintf("Alignment: %s\n", p.Alignment))
+	}
+	if len(p.PersonalityTraits) > 0 {
+		sb.WriteString(fmt.Sprintf("Personality traits: %s\n", strings.Join(p.PersonalityTraits, " ")))
+	}
+	if p.Ideal != "" {
+		sb.WriteString(fmt.Sprintf("Ideal: %s\n", p.Ideal))
+	}
+	if p.Bond != "" {
+		sb.WriteString(fmt.Sprintf("Bond: %s\n", p.Bond))
+	}
+	if p.Flaw != "" {
+		sb.WriteString(fmt.Sprintf("Flaw: %s\n", p.Flaw))
+	}
+	if p.Appearance != "" {
+		sb.WriteString(fmt.Sprintf("Appearance: %s\n", p.Appearance))
+	}
+	if p.Backstory != "" {
+		sb.WriteString(fmt.Sprintf("Backstory:\n%s\n", p.Backstory))
+	}
+	return sb.String()
+}
+
+func printSavingThrows(c *domain.Character) {
+	fmt.Println("Saving throws:")
+	for _, save := range c.SavingThrows() {
+		note := ""
+		if save.Proficient {
+			note = " (proficient)"
+		}
+		fmt.Printf("  %s: %+d%s\n", save.Ability, save.Bonus, note)
+	}
+}
+
 func printProficiencies(c *domain.Character) {
 	if len(c.SkillProficiencies) > 0 {
 		fmt.Printf("Skill proficiencies: %s\n", strings.Join(c.SkillProficiencies, ", "))
 	}
+	if len(c.SkillExpertise) > 0 {
+		fmt.Printf("Expertise: %s\n", strings.Join(c.SkillExpertise, ", "))
+	}
+	fmt.Printf("Armor proficiencies: %s\n", joinOrNone(c.ArmorProficiencies()))
+	fmt.Printf("Weapon proficiencies: %s\n", joinOrNone(c.WeaponProficiencies()))
+	fmt.Printf("Tool proficiencies: %s\n", joinOrNone(c.ToolProficiencies()))
+	fmt.Printf("Languages: %s\n", formatLanguages(c.Languages, c.LanguageChoices()))
+	fmt.Println("Skills:")
+	for _, skill := range domain.NewSkillRepository().AllSkills() {
+		fmt.Printf("  %s (%s): %+d%s\n", skill.Name, skill.Ability, c.SkillBonus(skill), proficiencyNote(c.SkillProficiency(skill.Name)))
+	}
+}
+
+func proficiencyNote(level domain.ProficiencyLevel) string {
+	if level == domain.ProficiencyNone {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", level)
 }
 
 func printEquipment(c *domain.Character) {
@@ -93,10 +215,31 @@ func printEquipment(c *domain.Character) {
 	if c.Equipment.Shield != nil {
 		fmt.Printf("Shield: %s\n", c.Equipment.Shield.Name)
 	}
+	if len(c.Inventory) > 0 {
+		fmt.Printf("Inventory: %s\n", strings.Join(c.Inventory, ", "))
+	}
+	fmt.Printf("Currency: %s\n", formatCurrency(c.Currency))
+}
+
+func formatCurrency(purse domain.Currency) string {
+	coins := []struct {
+		amount int
+		unit   string
+	}{{purse.PP, "pp"}, {purse.GP, "gp"}, {purse.EP, "ep"}, {purse.SP, "sp"}, {purse.CP, "cp"}}
+	var parts []string
+	for _, coin := range coins {
+		if coin.amount > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", coin.amount, coin.unit))
+		}
+	}
+	if len(parts) == 0 {
+		return "0 gp"
+	}
+	return strings.Join(parts, ", ")
 }
 
 func printSpells(c *domain.Character) {
-	if !domain.IsSpellcastingClass(string(c.Class)) || len(c.SpellSlots) == 0 {
+	if !c.IsSpellcaster() || (len(c.SpellSlots) == 0 && c.PactSlots == 0) {
 		return
 	}
 
@@ -107,21 +250,120 @@ func printSpells(c *domain.Character) {
 			if lvl == 0 {
 				label = "Level 0"
 			}
-			fmt.Printf("  %s: %d\n", label, count)
+			fmt.Printf("  %s: %d/%d\n", label, c.SpellSlotsRemaining()[lvl], count)
 		}
 	}
+	if c.PactSlots > 0 {
+		fmt.Printf("  Pact magic: %d/%d slots of level %d\n", c.PactSlotsRemaining(), c.PactSlots, c.PactSlotLevel)
+	}
 
 	fullName := FullAbilityName(c.SpellcastingAbility)
 	fmt.Printf("Spellcasting ability: %s\nSpell save DC: %d\nSpell attack bonus: +%d\n",
 		fullName, c.SpellSaveDC, c.SpellAttackBonus)
+	fmt.Print(formatSpellLimits(c))
+	if len(c.Spells) > 0 {
+		fmt.Printf("Known spells: %s\n", strings.Join(spellNames(c.Spells), ", "))
+	}
+	if c.HasSpellbook() {
+		fmt.Printf("Spellbook: %s\n", strings.Join(spellNames(c.Spellbook), ", "))
+	}
+	if len(c.PreparedSpells) > 0 {
+		fmt.Printf("Prepared spells: %s\n", strings.Join(spellNames(c.PreparedSpells), ", "))
+	}
+}
+
+func spellNames(spells []domain.Spell) []string {
+	names := make([]string, 0, len(spells))
+	for _, sp := range spells {
+		names = append(names, sp.Name)
+	}
+	return names
+}
+
+func formatSpellLimits(c *domain.Character) string {
+	var sb strings.Builder
+	for _, limit := range c.SpellLimits() {
+		if limit.MaxCantrips > 0 {
+			sb.WriteString(fmt.Sprintf("Cantrips known (%s): %d/%d\n", limit.Class, limit.Cantrips, limit.MaxCantrips))
+		}
+		if limit.MaxSpells > 0 {
+			sb.WriteString(fmt.Sprintf("Spells known (%s): %d/%d, up to level %d\n",
+				limit.Class, limit.Spells, limit.MaxSpells, limit.MaxSpellLevel))
+		}
+		if limit.MaxPrepared > 0 {
+			sb.WriteString(fmt.Sprintf("Prepared spells (%s): %d/%d, up to level %d\n",
+				limit.Class, limit.Prepared, limit.MaxPrepared, limit.MaxSpellLevel))
+		}
+	}
+	if c.HasSpellbook() {
+		sb.WriteString(fmt.Sprintf("Spellbook: %d spells, %d free to add (copy more for %d gp per spell level)\n",
+			len(c.Spellbook), c.FreeSpellbookSpellsLeft(), domain.SpellCopyCost(domain.Spell{Level: 1}).Gold))
+	}
+	return sb.String()
 }
 
 func printCombatStats(c *domain.Character) {
-	fmt.Printf("\nArmor class: %d\nInitiative bonus: %d\nPassive perception: %d\n",
-		c.ArmorClass, c.Initiative, c.PassivePerception)
+	fmt.Printf("\nArmor class: %d\nInitiative bonus: %d\n", c.ArmorClass, c.Initiative)
+	for _, skill := range domain.PassiveSkills {
+		fmt.Printf("Passive %s: %d\n", strings.ToLower(skill), c.PassiveScore(skill))
+	}
+	fmt.Printf("Hit points: %s\nHit dice: %s (of %s)\n",
+		formatHitPoints(c), domain.FormatHitDice(c.HitDiceRemaining()), domain.FormatHitDice(c.HitDice()))
 }
 
+func printConditions(c *domain.Character) {
+	conditions := c.ActiveConditions()
+	if len(conditions) == 0 {
+		return
+	}
+	fmt.Println("\nConditions:")
+	for _, condition := range conditions {
+		fmt.Printf("  %s: %s\n", condition.Name, condition.Summary)
+	}
+}
 
+func printResources(c *domain.Character) {
+	resources := c.Resources()
+	if len(resources) == 0 {
+		return
+	}
+	fmt.Println("\nResources:")
+	for _, r := range resources {
+		fmt.Printf("  %s: %s (recovers on %s)\n", r.Name, formatResourceUses(r), r.Recovery)
+	}
+}
+
+func printFeats(c *domain.Character) {
+	if len(c.Feats) == 0 {
+		return
+	}
+	fmt.Println("\nFeats:")
+	for _, f := range c.Feats {
+		fmt.Printf("  %s\n", formatFeat(f))
+	}
+}
+
+func formatFeat(f domain.CharacterFeat) string {
+	if f.Ability == "" {
+		return f.Name
+	}
+	return fmt.Sprintf("%s (+1 %s)", f.Name, f.Ability)
+}
+
+func printFeatures(c *domain.Character) {
+	features := c.Features()
+	if len(features) == 0 {
+		return
+	}
+	fmt.Println("\nFeatures:")
+	for _, f := range features {
+		if detail := f.Detail(); detail != "" {
+			fmt.Printf("  %s (%s)\n", f.Name, detail)
+		} else {
+			fmt.Printf("  %s\n", f.Name)
+		}
+	}
+}
 
 func FullAbilityName(short string) string {
 	switch strings.ToUpper(short) {